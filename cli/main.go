@@ -11,6 +11,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/mgechev/revive/config"
+	"github.com/mgechev/revive/lint"
 	"github.com/mgechev/revive/revivelib"
 	"github.com/mitchellh/go-homedir"
 	"github.com/spf13/afero"
@@ -40,6 +41,14 @@ func RunRevive(extraRules ...revivelib.ExtraRule) {
 		fail(err.Error())
 	}
 
+	if maxWorkers > 0 {
+		conf.MaxWorkers = maxWorkers
+	}
+
+	if failOn != "" {
+		conf.FailOn = lint.Severity(failOn)
+	}
+
 	revive, err := revivelib.New(
 		conf,
 		setExitStatus,
@@ -66,15 +75,11 @@ func RunRevive(extraRules ...revivelib.ExtraRule) {
 		fail(err.Error())
 	}
 
-	output, exitCode, err := revive.Format(formatterName, failures)
+	exitCode, err := revive.FormatTo(formatterName, failures, os.Stdout)
 	if err != nil {
 		fail(err.Error())
 	}
 
-	if output != "" {
-		fmt.Println(output)
-	}
-
 	os.Exit(exitCode)
 }
 
@@ -85,6 +90,8 @@ var (
 	versionFlag     bool
 	setExitStatus   bool
 	maxOpenFiles    int
+	maxWorkers      int
+	failOn          string
 )
 
 var originalUsage = flag.Usage
@@ -149,6 +156,8 @@ func initConfig() {
 		versionUsage      = "get revive version"
 		exitStatusUsage   = "set exit status to 1 if any issues are found, overwrites errorCode and warningCode in config"
 		maxOpenFilesUsage = "maximum number of open files at the same time"
+		maxWorkersUsage   = "maximum number of files linted at the same time, defaults to GOMAXPROCS"
+		failOnUsage       = "minimum severity (warning or error) of the failures setting the exit status, overwrites failOn in config"
 	)
 
 	defaultConfigPath := buildDefaultConfigPath()
@@ -159,6 +168,8 @@ func initConfig() {
 	flag.BoolVar(&versionFlag, "version", false, versionUsage)
 	flag.BoolVar(&setExitStatus, "set_exit_status", false, exitStatusUsage)
 	flag.IntVar(&maxOpenFiles, "max_open_files", 0, maxOpenFilesUsage)
+	flag.IntVar(&maxWorkers, "max_workers", 0, maxWorkersUsage)
+	flag.StringVar(&failOn, "fail_on", "", failOnUsage)
 	flag.Parse()
 
 	// Output build info (version, commit, date and builtBy)
@@ -96,6 +96,79 @@ var allRules = append([]lint.Rule{
 	&rule.EnforceSliceStyleRule{},
 	&rule.MaxControlNestingRule{},
 	&rule.CommentsDensityRule{},
+	&rule.ErrorStringComparisonRule{},
+	&rule.RangeChannelRule{},
+	&rule.ImplicitExportedMethodRule{},
+	&rule.ZeroValueLiteralRule{},
+	&rule.UndifferentiatedErrorsRule{},
+	&rule.PreferFilepathJoinRule{},
+	&rule.PreferURLValuesRule{},
+	&rule.RegexpCompileInFuncRule{},
+	&rule.MarshalNoExportedFieldsRule{},
+	&rule.DeferUnlockRule{},
+	&rule.UnbalancedLockRule{},
+	&rule.AlwaysNilErrorRule{},
+	&rule.FlagArgumentRule{},
+	&rule.PanicValueTypeRule{},
+	&rule.SliceAliasingRule{},
+	&rule.HardcodedSecretRule{},
+	&rule.WeakCryptoRule{},
+	&rule.InsecureRandomRule{},
+	&rule.SQLUseContextRule{},
+	&rule.SQLRowsCloseRule{},
+	&rule.HTTPBodyCloseRule{},
+	&rule.PointerToInterfaceRule{},
+	&rule.StructTagAlignmentRule{},
+	&rule.ExportedMethodUnexportedTypeRule{},
+	&rule.NoTimeTickRule{},
+	&rule.TimerStopRule{},
+	&rule.RedundantAppendConversionRule{},
+	&rule.MisplacedErrorfVerbRule{},
+	&rule.WrappedUnexportedErrorRule{},
+	&rule.AmbiguousMutationSignatureRule{},
+	&rule.TypedNilComparisonRule{},
+	&rule.ErrorfNotErrorsNewSprintfRule{},
+	&rule.IncomparableTypeCompareRule{},
+	&rule.UnusedTypeParamRule{},
+	&rule.TightenConstraintRule{},
+	&rule.OverGenericFunctionRule{},
+	&rule.TestTableNamingRule{},
+	&rule.MissingTestHelperRule{},
+	&rule.ConsistentErrorWrappingRule{},
+	&rule.DocGoPackageCommentRule{},
+	&rule.NoGetPrefixRule{},
+	&rule.PrematureInterfaceRule{},
+	&rule.BuilderSetterReturnsRule{},
+	&rule.NoPanicInInitRule{},
+	&rule.UselessTagOnUnexportedRule{},
+	&rule.CombineAssignmentsRule{},
+	&rule.SimplifyBooleanRule{},
+	&rule.IfAssignToTernaryRule{},
+	&rule.ShadowedNamedResultRule{},
+	&rule.DeprecatedStdlibRule{},
+	&rule.NoContextInStructRule{},
+	&rule.NarrowingConversionRule{},
+	&rule.UnsignedLoopUnderflowRule{},
+	&rule.IntegerDivisionRule{},
+	&rule.ErrorfWrapVerbRule{},
+	&rule.AssignmentInConditionRule{},
+	&rule.NoSleepInTestsRule{},
+	&rule.EmptyCriticalSectionRule{},
+	&rule.DoubleLockRule{},
+	&rule.ConcurrentAppendRule{},
+	&rule.UnnecessarySprintfRule{},
+	&rule.DeferLoopCaptureRule{},
+	&rule.DeadStoreRule{},
+	&rule.LoopVarCaptureRule{},
+	&rule.NilParamCheckRule{},
+	&rule.CallArgumentLimitRule{},
+	&rule.NoSensitiveLoggingRule{},
+	&rule.PreferSwitchRule{},
+	&rule.UnkeyedStructLiteralRule{},
+	&rule.RedundantLabelRule{},
+	&rule.AlwaysTrueOkRule{},
+	&rule.ExportedErrorDocRule{},
+	&rule.InefficientPrependRule{},
 }, defaultRules...)
 
 var allFormatters = []lint.Formatter{
@@ -108,6 +181,10 @@ var allFormatters = []lint.Formatter{
 	&formatter.Checkstyle{},
 	&formatter.Plain{},
 	&formatter.Sarif{},
+	&formatter.GitHubActions{},
+	&formatter.JUnit{},
+	&formatter.GitLab{},
+	&formatter.JSONSummary{},
 }
 
 func getFormatters() map[string]lint.Formatter {
@@ -182,6 +259,10 @@ func normalizeConfig(config *lint.Config) {
 	if len(config.Rules) == 0 {
 		config.Rules = map[string]lint.RuleConfig{}
 	}
+	config.RegisteredRules = make([]string, 0, len(allRules))
+	for _, r := range allRules {
+		config.RegisteredRules = append(config.RegisteredRules, r.Name())
+	}
 	if config.EnableAllRules {
 		// Add to the configuration all rules not yet present in it
 		for _, r := range allRules {
@@ -195,8 +276,9 @@ func normalizeConfig(config *lint.Config) {
 		}
 	}
 
+	// rules without an explicit severity keep it unset when it depends on the confidence of their failures
 	severity := config.Severity
-	if severity != "" {
+	if severity != "" && len(config.ConfidenceSeverity) == 0 {
 		for k, v := range config.Rules {
 			if v.Severity == "" {
 				v.Severity = severity
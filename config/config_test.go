@@ -1,7 +1,9 @@
 package config
 
 import (
+	"go/token"
 	"reflect"
+	"sort"
 	"strings"
 	"testing"
 
@@ -143,6 +145,12 @@ func TestGetGlobalSeverity(t *testing.T) {
 			particularRule:         &rule.DeepExitRule{},
 			wantParticularSeverity: "warning",
 		},
+		"confidence thresholds with one specific severity": {
+			confPath:               "testdata/confidenceSeverity.toml",
+			wantGlobalSeverity:     "", // depends on the confidence of the failures
+			particularRule:         &rule.CyclomaticRule{},
+			wantParticularSeverity: "warning",
+		},
 	}
 
 	for name, tc := range tt {
@@ -173,3 +181,118 @@ func TestGetGlobalSeverity(t *testing.T) {
 		})
 	}
 }
+
+func TestDirectoryConfigs(t *testing.T) {
+	cfg, err := GetConfig("testdata/directories/base.toml")
+	if err != nil {
+		t.Fatalf("Unexpected error while loading conf: %v", err)
+	}
+	rules, err := GetLintingRules(cfg, []lint.Rule{})
+	if err != nil {
+		t.Fatalf("Unexpected error while loading rules: %v", err)
+	}
+	SetDirectoryConfigs(cfg, rules, []lint.Rule{})
+
+	tt := map[string]struct {
+		filename         string
+		wantRules        []string
+		wantArgumentsMax int64
+		wantSeverity     lint.Severity
+		wantBaseRule     bool
+		wantDirective    bool
+	}{
+		"no configuration file": {
+			filename:         "testdata/directories/other/file.go",
+			wantRules:        []string{"argument-limit", "var-naming"},
+			wantArgumentsMax: 3,
+			wantBaseRule:     true,
+		},
+		"configuration file in the directory": {
+			filename:         "testdata/directories/team/file.go",
+			wantRules:        []string{"argument-limit"},
+			wantArgumentsMax: 5,
+			wantSeverity:     lint.SeverityError,
+		},
+		"nearest configuration file wins": {
+			filename:         "testdata/directories/team/service/file.go",
+			wantRules:        []string{"argument-limit", "var-naming"},
+			wantArgumentsMax: 8,
+			wantSeverity:     lint.SeverityError,
+		},
+		"root configuration file stops the discovery": {
+			filename:         "testdata/directories/team/isolated/file.go",
+			wantRules:        []string{"argument-limit", "var-naming"},
+			wantArgumentsMax: 6,
+			wantDirective:    true,
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			fileRules, fileCfg, err := cfg.ConfigForFile(tc.filename)
+			if err != nil {
+				t.Fatalf("Unexpected error\n\t%v", err)
+			}
+
+			var gotRules []string
+			for _, r := range fileRules {
+				gotRules = append(gotRules, r.Name())
+				if r.Name() == "argument-limit" && (r == rules[0] || r == rules[1]) != tc.wantBaseRule {
+					t.Fatalf("Expected argument-limit to be the base instance: %v", tc.wantBaseRule)
+				}
+			}
+			sort.Strings(gotRules)
+			if !reflect.DeepEqual(gotRules, tc.wantRules) {
+				t.Fatalf("Expected rules %v, got %v", tc.wantRules, gotRules)
+			}
+
+			ruleCfg := fileCfg.Rules["argument-limit"]
+			if got := ruleCfg.Arguments[0]; got != tc.wantArgumentsMax {
+				t.Fatalf("Expected argument-limit arguments [%v], got %v", tc.wantArgumentsMax, ruleCfg.Arguments)
+			}
+			if ruleCfg.Severity != tc.wantSeverity {
+				t.Fatalf("Expected severity %q, got %q", tc.wantSeverity, ruleCfg.Severity)
+			}
+			if _, ok := fileCfg.Directives["specify-disable-reason"]; ok != tc.wantDirective {
+				t.Fatalf("Expected the specify-disable-reason directive to be configured: %v", tc.wantDirective)
+			}
+		})
+	}
+
+	t.Run("ambiguous configuration files", func(t *testing.T) {
+		_, _, err := cfg.ConfigForFile("testdata/directories/ambiguous/file.go")
+		if err == nil || !strings.Contains(err.Error(), "both .revive.toml and revive.toml exist") {
+			t.Fatalf("Expected an ambiguity error, got %v", err)
+		}
+	})
+
+	t.Run("malformed configuration file", func(t *testing.T) {
+		_, _, err := cfg.ConfigForFile("testdata/directories/malformed/file.go")
+		if err == nil || !strings.Contains(err.Error(), "cannot parse the config file") {
+			t.Fatalf("Expected a parsing error, got %v", err)
+		}
+	})
+}
+
+func TestFormatterOptions(t *testing.T) {
+	cfg, err := GetConfig("testdata/formatterOptions.toml")
+	if err != nil {
+		t.Fatalf("Unexpected error while loading conf: %v", err)
+	}
+	fmtr, err := GetFormatter("junit")
+	if err != nil {
+		t.Fatalf("Unexpected error while getting formatter: %v", err)
+	}
+
+	failures := make(chan lint.Failure, 1)
+	failures <- lint.Failure{Failure: "failed", RuleName: "var-naming", Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 1, Column: 1}}}
+	close(failures)
+
+	output, err := fmtr.Format(failures, *cfg)
+	if err != nil {
+		t.Fatalf("Unexpected error while formatting: %v", err)
+	}
+	if want := `<skipped message="failed" type="var-naming">`; !strings.Contains(output, want) {
+		t.Fatalf("Expected the output\n%s\nto contain %s", output, want)
+	}
+}
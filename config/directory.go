@@ -0,0 +1,216 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/mgechev/revive/lint"
+)
+
+// DirectoryConfigFiles are the names of the configuration files discovered in the directories of the linted files
+var DirectoryConfigFiles = []string{".revive.toml", "revive.toml"}
+
+// effectiveConfig is the configuration, and the corresponding rules, to apply to the files of a directory
+type effectiveConfig struct {
+	config lint.Config
+	rules  []lint.Rule
+}
+
+// directoryConfigs merges the configuration files discovered in the directories of the linted files
+type directoryConfigs struct {
+	extraRules []lint.Rule
+	base       *effectiveConfig
+	byDir      map[string]*effectiveConfig
+	sync.Mutex
+}
+
+// SetDirectoryConfigs makes the linter discover, for every linted file, the configuration files (see DirectoryConfigFiles)
+// found in the directory of the file and in its parent directories, up to the first one marked with root = true.
+// The rules and directives configured by these files are merged over those of the given configuration: the nearest file wins.
+func SetDirectoryConfigs(config *lint.Config, lintingRules, extraRules []lint.Rule) {
+	dc := &directoryConfigs{
+		extraRules: extraRules,
+		base:       &effectiveConfig{config: *config, rules: lintingRules},
+		byDir:      map[string]*effectiveConfig{},
+	}
+	config.ConfigForFile = dc.forFile
+}
+
+func (dc *directoryConfigs) forFile(filename string) ([]lint.Rule, lint.Config, error) {
+	dir, err := filepath.Abs(filepath.Dir(filename))
+	if err != nil {
+		return nil, lint.Config{}, err
+	}
+
+	dc.Lock()
+	defer dc.Unlock()
+
+	effective, err := dc.resolve(dir)
+	if err != nil {
+		return nil, lint.Config{}, err
+	}
+
+	return effective.rules, effective.config, nil
+}
+
+// resolve returns the effective configuration of the given directory, dc must be locked
+func (dc *directoryConfigs) resolve(dir string) (*effectiveConfig, error) {
+	if effective, ok := dc.byDir[dir]; ok {
+		return effective, nil
+	}
+
+	path, err := configFileIn(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var dirConfig *lint.Config
+	isRoot := false
+	if path != "" {
+		dirConfig, isRoot, err = parseDirectoryConfig(path)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+
+	parent := dc.base
+	if parentDir := filepath.Dir(dir); parentDir != dir && !isRoot {
+		parent, err = dc.resolve(parentDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	effective := parent
+	if dirConfig != nil {
+		effective, err = dc.merge(parent, dirConfig)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+
+	dc.byDir[dir] = effective
+	return effective, nil
+}
+
+// configFileIn returns the path of the configuration file of the given directory, or an empty string if there is none
+func configFileIn(dir string) (string, error) {
+	found := ""
+	for _, name := range DirectoryConfigFiles {
+		path := filepath.Join(dir, name)
+		if _, err := os.Stat(path); err != nil {
+			continue
+		}
+		if found != "" {
+			return "", fmt.Errorf("ambiguous configuration of %s: both %s and %s exist", dir, filepath.Base(found), name)
+		}
+		found = path
+	}
+
+	return found, nil
+}
+
+// parseDirectoryConfig parses the given configuration file, it also returns whether the file stops the discovery of configuration files
+func parseDirectoryConfig(path string) (*lint.Config, bool, error) {
+	dirConfig := &lint.Config{}
+	if err := parseConfig(path, dirConfig); err != nil {
+		return nil, false, err
+	}
+
+	var marker struct {
+		Root bool `toml:"root"`
+	}
+	if _, err := toml.DecodeFile(path, &marker); err != nil {
+		return nil, false, err
+	}
+
+	return dirConfig, marker.Root, nil
+}
+
+// merge returns the configuration resulting from overriding the rules and directives of parent with those of the given configuration
+func (dc *directoryConfigs) merge(parent *effectiveConfig, dirConfig *lint.Config) (*effectiveConfig, error) {
+	severity := dirConfig.Severity
+	if severity == "" {
+		severity = parent.config.Severity
+	}
+
+	merged := parent.config
+	merged.Severity = severity
+	merged.Rules = make(lint.RulesConfig, len(parent.config.Rules))
+	for name, ruleConfig := range parent.config.Rules {
+		merged.Rules[name] = ruleConfig
+	}
+	for name, ruleConfig := range dirConfig.Rules {
+		if ruleConfig.Severity == "" && len(merged.ConfidenceSeverity) == 0 {
+			ruleConfig.Severity = severity
+		}
+		merged.Rules[name] = ruleConfig
+	}
+	merged.Directives = make(lint.DirectivesConfig, len(parent.config.Directives))
+	for name, directiveConfig := range parent.config.Directives {
+		merged.Directives[name] = directiveConfig
+	}
+	for name, directiveConfig := range dirConfig.Directives {
+		if directiveConfig.Severity == "" && len(merged.ConfidenceSeverity) == 0 {
+			directiveConfig.Severity = severity
+		}
+		merged.Directives[name] = directiveConfig
+	}
+	if dirConfig.EnableAllRules {
+		for _, r := range allRules {
+			if _, alreadyInConf := merged.Rules[r.Name()]; !alreadyInConf {
+				ruleConfig := lint.RuleConfig{}
+				if len(merged.ConfidenceSeverity) == 0 {
+					ruleConfig.Severity = severity
+				}
+				merged.Rules[r.Name()] = ruleConfig
+			}
+		}
+	}
+
+	rules, err := GetLintingRules(&merged, dc.extraRules)
+	if err != nil {
+		return nil, err
+	}
+
+	// rules keep their configuration once applied, thus rules configured with different arguments must be distinct instances
+	parentRules := map[string]lint.Rule{}
+	for _, r := range parent.rules {
+		parentRules[r.Name()] = r
+	}
+	for i, r := range rules {
+		parentRule, ok := parentRules[r.Name()]
+		if ok && reflect.DeepEqual(ruleArguments(parent.config, r.Name()), ruleArguments(merged, r.Name())) {
+			rules[i] = parentRule
+			continue
+		}
+		rules[i] = newRuleInstance(r)
+	}
+
+	return &effectiveConfig{config: merged, rules: rules}, nil
+}
+
+// ruleArguments returns the arguments of the named rule in the given configuration
+func ruleArguments(config lint.Config, ruleName string) lint.Arguments {
+	for name, ruleConfig := range config.Rules {
+		if actualRuleName(name) == ruleName {
+			return ruleConfig.Arguments
+		}
+	}
+
+	return nil
+}
+
+// newRuleInstance returns a new, not yet configured, instance of the given rule
+func newRuleInstance(r lint.Rule) lint.Rule {
+	t := reflect.TypeOf(r)
+	if t.Kind() != reflect.Ptr || t.Elem().Kind() != reflect.Struct {
+		return r
+	}
+
+	return reflect.New(t.Elem()).Interface().(lint.Rule)
+}
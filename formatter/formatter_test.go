@@ -1,10 +1,14 @@
 package formatter_test
 
 import (
+	"bufio"
 	"go/token"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mgechev/revive/formatter"
 	"github.com/mgechev/revive/lint"
@@ -59,13 +63,34 @@ Warnings:
   1  rule
 `,
 		},
+		{
+			formatter: &formatter.GitHubActions{},
+			want:      `::warning file=test.go,line=2,col=5::test failure [rule] (confidence 0)`,
+		},
+		{
+			formatter: &formatter.GitLab{},
+			want:      `[{"description":"test failure","check_name":"rule","fingerprint":"d5ae6aa110179e284ef1bbf4525aebf11202fd0991ae049036bff55925931a68","severity":"minor","location":{"path":"test.go","lines":{"begin":2}}}]`,
+		},
 		{
 			formatter: &formatter.JSON{},
-			want:      `[{"Severity":"warning","Failure":"test failure","RuleName":"rule","Category":"cat","Position":{"Start":{"Filename":"test.go","Offset":0,"Line":2,"Column":5},"End":{"Filename":"test.go","Offset":0,"Line":2,"Column":10}},"Confidence":0,"ReplacementLine":""}]`,
+			want:      `[{"Severity":"warning","RuleURL":"https://revive.run/r#rule","Failure":"test failure","RuleName":"rule","Category":"cat","Position":{"Start":{"Filename":"test.go","Offset":0,"Line":2,"Column":5},"End":{"Filename":"test.go","Offset":0,"Line":2,"Column":10}},"Confidence":0,"ReplacementLine":""}]`,
+		},
+		{
+			formatter: &formatter.JUnit{},
+			want: `
+<?xml version="1.0" encoding="UTF-8"?>
+<testsuites name="revive" tests="1" failures="1" skipped="0">
+  <testsuite name="test.go" tests="1" failures="1" skipped="0">
+    <testcase name="rule (2:5)" classname="test.go">
+      <failure message="test failure" type="rule">test.go:2:5: test failure (confidence 0)</failure>
+    </testcase>
+  </testsuite>
+</testsuites>
+`,
 		},
 		{
 			formatter: &formatter.NDJSON{},
-			want:      `{"Severity":"warning","Failure":"test failure","RuleName":"rule","Category":"cat","Position":{"Start":{"Filename":"test.go","Offset":0,"Line":2,"Column":5},"End":{"Filename":"test.go","Offset":0,"Line":2,"Column":10}},"Confidence":0,"ReplacementLine":""}`,
+			want:      `{"Severity":"warning","RuleURL":"https://revive.run/r#rule","Failure":"test failure","RuleName":"rule","Category":"cat","Position":{"Start":{"Filename":"test.go","Offset":0,"Line":2,"Column":5},"End":{"Filename":"test.go","Offset":0,"Line":2,"Column":10}},"Confidence":0,"ReplacementLine":""}`,
 		},
 		{
 			formatter: &formatter.Plain{},
@@ -79,6 +104,7 @@ Warnings:
     {
       "results": [
         {
+          "level": "warning",
           "locations": [
             {
               "physicalLocation": {
@@ -101,7 +127,16 @@ Warnings:
       "tool": {
         "driver": {
           "informationUri": "https://revive.run",
-          "name": "revive"
+          "name": "revive",
+          "rules": [
+            {
+              "helpUri": "https://revive.run/r#rule",
+              "id": "rule",
+              "properties": {
+                "severity": "warning"
+              }
+            }
+          ]
         }
       }
     }
@@ -163,3 +198,236 @@ test.go
 		})
 	}
 }
+
+func TestGitHubActionsEscaping(t *testing.T) {
+	failures := make(chan lint.Failure, 1)
+	failures <- lint.Failure{
+		Failure:    "100% wrong:\nsee details",
+		RuleName:   "rule",
+		Confidence: 1,
+		Position: lint.FailurePosition{
+			Start: token.Position{Filename: "dir,name/test:1.go", Line: 2, Column: 5},
+		},
+	}
+	close(failures)
+
+	config := lint.Config{Rules: lint.RulesConfig{"rule": {Severity: lint.SeverityError}}}
+	output, err := (&formatter.GitHubActions{}).Format(failures, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "::error file=dir%2Cname/test%3A1.go,line=2,col=5::100%25 wrong:%0Asee details [rule] (confidence 1)\n"
+	if output != want {
+		t.Errorf("got %q, want %q", output, want)
+	}
+}
+
+func TestJUnitSkipWarnings(t *testing.T) {
+	failures := make(chan lint.Failure, 2)
+	failures <- lint.Failure{
+		Failure:  "a < b & c",
+		RuleName: "warned",
+		Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 1, Column: 1}},
+	}
+	failures <- lint.Failure{
+		Failure:  "failed",
+		RuleName: "errored",
+		Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 3, Column: 2}},
+	}
+	close(failures)
+
+	config := lint.Config{Rules: lint.RulesConfig{"errored": {Severity: lint.SeverityError}}}
+	output, err := (&formatter.JUnit{SkipWarnings: true}).Format(failures, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`<testsuite name="a.go" tests="2" failures="1" skipped="1">`,
+		`<skipped message="a &lt; b &amp; c" type="warned">`,
+		`<failure message="failed" type="errored">`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output %q does not contain %q", output, want)
+		}
+	}
+}
+
+func TestJSONRuleURL(t *testing.T) {
+	failures := make(chan lint.Failure, 2)
+	failures <- lint.Failure{Failure: "failed", RuleName: "var-naming", Category: "naming"}
+	failures <- lint.Failure{Failure: "invalid file", Category: "validity"}
+	close(failures)
+
+	config := lint.Config{RuleDocsURL: "https://example.com/rules#"}
+	output, err := (&formatter.JSON{}).Format(failures, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `[{"Severity":"warning","RuleURL":"https://example.com/rules#var-naming","Failure":"failed","RuleName":"var-naming","Category":"naming",`
+	if !strings.HasPrefix(output, want) {
+		t.Errorf("output %q does not start with %q", output, want)
+	}
+	if strings.Count(output, "RuleURL") != 1 {
+		t.Errorf("expected no rule URL for the failure without rule, got %q", output)
+	}
+}
+
+func TestSarifRules(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failures := make(chan lint.Failure, 3)
+	for _, name := range []string{"first", "second", "first"} {
+		failures <- lint.Failure{
+			Failure:  "failed",
+			RuleName: name,
+			Position: lint.FailurePosition{Start: token.Position{Filename: filepath.Join(wd, "pkg", "a.go"), Line: 1, Column: 1}},
+		}
+	}
+	close(failures)
+
+	config := lint.Config{Rules: lint.RulesConfig{"second": {Severity: lint.SeverityError}}}
+	output, err := (&formatter.Sarif{}).Format(failures, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got := strings.Count(output, `"helpUri"`); got != 2 {
+		t.Errorf("expected each rule to be listed once, got %d rules in %s", got, output)
+	}
+	for _, want := range []string{`"uri": "pkg/a.go"`, `"level": "error"`} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output %s does not contain %s", output, want)
+		}
+	}
+}
+
+func TestRelatedInformation(t *testing.T) {
+	failure := lint.Failure{
+		Failure:  "this declaration shadows the named result err",
+		RuleName: "shadowed-named-result",
+		Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 5, Column: 3}},
+		RelatedInformation: []lint.RelatedInformation{{
+			Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 1, Column: 20}},
+			Message:  "named result err is declared here",
+		}},
+	}
+
+	for _, td := range []struct {
+		formatter lint.Formatter
+		want      []string
+	}{
+		{
+			formatter: &formatter.JSON{},
+			want:      []string{`"RelatedInformation":[{"Position":{"Start":{"Filename":"a.go","Offset":0,"Line":1,"Column":20}`, `"Message":"named result err is declared here"`},
+		},
+		{
+			formatter: &formatter.Sarif{},
+			want:      []string{`"relatedLocations": [`, `"text": "named result err is declared here"`, `"startLine": 1`},
+		},
+	} {
+		t.Run(td.formatter.Name(), func(t *testing.T) {
+			failures := make(chan lint.Failure, 1)
+			failures <- failure
+			close(failures)
+
+			output, err := td.formatter.Format(failures, lint.Config{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, want := range td.want {
+				if !strings.Contains(output, want) {
+					t.Errorf("output %s does not contain %s", output, want)
+				}
+			}
+		})
+	}
+}
+
+func TestConfidenceSeverity(t *testing.T) {
+	failures := make(chan lint.Failure, 3)
+	failures <- lint.Failure{Failure: "sure", RuleName: "var-naming", Confidence: 0.9, Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 1}}}
+	failures <- lint.Failure{Failure: "unsure", RuleName: "var-naming", Confidence: 0.5, Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 2}}}
+	failures <- lint.Failure{Failure: "explicit", RuleName: "exported", Confidence: 0.9, Position: lint.FailurePosition{Start: token.Position{Filename: "a.go", Line: 3}}}
+	close(failures)
+
+	config := lint.Config{
+		Severity: lint.SeverityWarning,
+		Rules: lint.RulesConfig{
+			"var-naming": {},
+			"exported":   {Severity: lint.SeverityWarning},
+		},
+		ConfidenceSeverity: []lint.ConfidenceSeverity{{Min: 0.8, Severity: lint.SeverityError}},
+	}
+	output, err := (&formatter.Checkstyle{}).Format(failures, config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []string{
+		`message="sure (confidence 0.9)" severity="error"`,
+		`message="unsure (confidence 0.5)" severity="warning"`,
+		`message="explicit (confidence 0.9)" severity="warning"`,
+	} {
+		if !strings.Contains(output, want) {
+			t.Errorf("output %s does not contain %s", output, want)
+		}
+	}
+}
+
+func TestJSONSummary(t *testing.T) {
+	failures := make(chan lint.Failure, 2)
+	failures <- lint.Failure{Failure: "failed", RuleName: "var-naming"}
+	failures <- lint.Failure{Failure: "failed again", RuleName: "exported"}
+	close(failures)
+
+	config := lint.Config{Rules: lint.RulesConfig{"exported": {Severity: lint.SeverityError}}}
+	summary := func() lint.Summary {
+		return lint.Summary{Files: 3, Rules: 2, Duration: 1500 * time.Millisecond}
+	}
+	output, err := (&formatter.JSONSummary{}).FormatWithSummary(failures, config, summary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"Summary":{"Files":3,"Rules":2,"DurationMs":1500,"Failures":{"error":1,"warning":1}},"Failures":[`
+	if !strings.HasPrefix(output, want) {
+		t.Errorf("output %q does not start with %q", output, want)
+	}
+}
+
+func TestNDJSONStream(t *testing.T) {
+	failures := make(chan lint.Failure)
+	reader, writer := io.Pipe()
+	done := make(chan error)
+	go func() {
+		err := (&formatter.NDJSON{}).FormatStream(failures, lint.Config{}, writer)
+		writer.Close()
+		done <- err
+	}()
+
+	lines := bufio.NewScanner(reader)
+	for _, msg := range []string{"first", "second"} {
+		failures <- lint.Failure{Failure: msg, RuleName: "rule"}
+		// the failure must be written before the next one is received
+		if !lines.Scan() {
+			t.Fatalf("no line written for the failure %q", msg)
+		}
+		if !strings.Contains(lines.Text(), `"Failure":"`+msg+`"`) {
+			t.Errorf("line %s does not hold the failure %q", lines.Text(), msg)
+		}
+	}
+	close(failures)
+
+	if lines.Scan() {
+		t.Errorf("unexpected line %s", lines.Text())
+	}
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"strings"
 
 	"github.com/fatih/color"
 	"github.com/mgechev/revive/lint"
@@ -59,6 +60,7 @@ func (f *Friendly) printFriendlyFailure(w io.Writer, failure lint.Failure, sever
 	f.printHeaderRow(w, failure, severity)
 	f.printFilePosition(w, failure)
 	fmt.Fprintln(w)
+	f.printSnippet(w, failure)
 	fmt.Fprintln(w)
 }
 
@@ -74,6 +76,31 @@ func (*Friendly) printFilePosition(w io.Writer, failure lint.Failure) {
 	fmt.Fprintf(w, "  %s:%d:%d", failure.GetFilename(), failure.Position.Start.Line, failure.Position.Start.Column)
 }
 
+// printSnippet prints the source line of the failure with a caret under its starting column
+func (*Friendly) printSnippet(w io.Writer, failure lint.Failure) {
+	line, ok := failure.SourceLine()
+	if !ok {
+		return
+	}
+
+	column := failure.Position.Start.Column - 1
+	if column < 0 || column > len(line) {
+		column = 0
+	}
+
+	// keep tabs to align the caret with the source line
+	indent := strings.Map(func(r rune) rune {
+		if r == '\t' {
+			return r
+		}
+		return ' '
+	}, line[:column])
+
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "    %s\n", line)
+	fmt.Fprintf(w, "    %s%s\n", indent, color.RedString("^"))
+}
+
 type statEntry struct {
 	name     string
 	failures int
@@ -0,0 +1,49 @@
+package formatter
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// GitHubActions is an implementation of the Formatter interface
+// which formats the errors to GitHub Actions workflow commands, thus failures are shown as annotations of pull requests
+//
+//	::warning file=main.go,line=24,col=9::should replace errors.New(fmt.Sprintf(...)) with fmt.Errorf(...) [errorf] (confidence 1)
+type GitHubActions struct {
+	Metadata lint.FormatterMetadata
+}
+
+// Name returns the name of the formatter
+func (*GitHubActions) Name() string {
+	return "github-actions"
+}
+
+// Format formats the failures gotten from the lint.
+func (*GitHubActions) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
+	var buf bytes.Buffer
+	for failure := range failures {
+		command := "warning"
+		if severity(config, failure) == lint.SeverityError {
+			command = "error"
+		}
+
+		message := fmt.Sprintf("%s [%s] (confidence %v)", failure.Failure, failure.RuleName, failure.Confidence)
+		fmt.Fprintf(&buf, "::%s file=%s,line=%d,col=%d::%s\n",
+			command,
+			githubActionsPropertyEscaper.Replace(failure.GetFilename()),
+			failure.Position.Start.Line,
+			failure.Position.Start.Column,
+			githubActionsDataEscaper.Replace(message),
+		)
+	}
+	return buf.String(), nil
+}
+
+// escapers of the data and of the property values of workflow commands
+var (
+	githubActionsDataEscaper     = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A")
+	githubActionsPropertyEscaper = strings.NewReplacer("%", "%25", "\r", "%0D", "\n", "%0A", ":", "%3A", ",", "%2C")
+)
@@ -0,0 +1,76 @@
+package formatter
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// GitLab is an implementation of the Formatter interface
+// which formats the errors to GitLab Code Quality reports.
+type GitLab struct {
+	Metadata lint.FormatterMetadata
+}
+
+// Name returns the name of the formatter
+func (*GitLab) Name() string {
+	return "gitlab"
+}
+
+// gitlabIssue defines an issue of a GitLab Code Quality report
+type gitlabIssue struct {
+	Description string         `json:"description"`
+	CheckName   string         `json:"check_name"`
+	Fingerprint string         `json:"fingerprint"`
+	Severity    string         `json:"severity"`
+	Location    gitlabLocation `json:"location"`
+}
+
+type gitlabLocation struct {
+	Path  string      `json:"path"`
+	Lines gitlabLines `json:"lines"`
+}
+
+type gitlabLines struct {
+	Begin int `json:"begin"`
+}
+
+// gitlabSeverities maps severities to the severities of GitLab Code Quality reports (info, minor, major, critical or blocker)
+var gitlabSeverities = map[lint.Severity]string{
+	lint.SeverityWarning: "minor",
+	lint.SeverityError:   "major",
+}
+
+// Format formats the failures gotten from the lint.
+func (*GitLab) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
+	issues := []gitlabIssue{}
+	for failure := range failures {
+		filename := failure.GetFilename()
+		line := failure.Position.Start.Line
+		issues = append(issues, gitlabIssue{
+			Description: failure.Failure,
+			CheckName:   failure.RuleName,
+			Fingerprint: gitlabFingerprint(failure.RuleName, filename, line, failure.Failure),
+			Severity:    gitlabSeverities[severity(config, failure)],
+			Location: gitlabLocation{
+				Path:  filename,
+				Lines: gitlabLines{Begin: line},
+			},
+		})
+	}
+
+	result, err := json.Marshal(issues)
+	if err != nil {
+		return "", err
+	}
+	return string(result), nil
+}
+
+// gitlabFingerprint identifies an issue across runs
+func gitlabFingerprint(ruleName, filename string, line int, message string) string {
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%s\x00%s\x00%d\x00%s", ruleName, filename, line, message)))
+	return hex.EncodeToString(sum[:])
+}
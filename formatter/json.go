@@ -17,20 +17,42 @@ func (*JSON) Name() string {
 	return "json"
 }
 
+// defaultRuleDocsURL is the base URL of the rules documentation, when not set in the configuration
+const defaultRuleDocsURL = "https://revive.run/r#"
+
 // jsonObject defines a JSON object of an failure
 type jsonObject struct {
 	Severity     lint.Severity
+	RuleURL      string `json:",omitempty"`
 	lint.Failure `json:",inline"`
 }
 
+func newJSONObject(config lint.Config, failure lint.Failure) jsonObject {
+	return jsonObject{
+		Severity: severity(config, failure),
+		RuleURL:  ruleURL(config, failure.RuleName),
+		Failure:  failure,
+	}
+}
+
+// ruleURL returns the URL of the documentation of the given rule, or an empty string for failures not raised by a rule
+func ruleURL(config lint.Config, ruleName string) string {
+	if ruleName == "" {
+		return ""
+	}
+
+	baseURL := config.RuleDocsURL
+	if baseURL == "" {
+		baseURL = defaultRuleDocsURL
+	}
+	return baseURL + ruleName
+}
+
 // Format formats the failures gotten from the lint.
 func (*JSON) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
 	var slice []jsonObject
 	for failure := range failures {
-		obj := jsonObject{}
-		obj.Severity = severity(config, failure)
-		obj.Failure = failure
-		slice = append(slice, obj)
+		slice = append(slice, newJSONObject(config, failure))
 	}
 	result, err := json.Marshal(slice)
 	if err != nil {
@@ -0,0 +1,62 @@
+package formatter
+
+import (
+	"encoding/json"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// JSONSummary is an implementation of the Formatter interface
+// which formats the errors to JSON, along with a summary of the linting.
+type JSONSummary struct {
+	Metadata lint.FormatterMetadata
+}
+
+// Name returns the name of the formatter
+func (*JSONSummary) Name() string {
+	return "json-summary"
+}
+
+type jsonSummary struct {
+	Files      int
+	Rules      int
+	DurationMs int64
+	Failures   map[lint.Severity]int
+}
+
+type jsonSummaryReport struct {
+	Summary  jsonSummary
+	Failures []jsonObject
+}
+
+// Format formats the failures gotten from the lint.
+// Without the statistics of the linting, the summary only counts the failures.
+func (f *JSONSummary) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
+	return f.FormatWithSummary(failures, config, nil)
+}
+
+// FormatWithSummary formats the failures gotten from the lint, along with the summary of the linting.
+func (*JSONSummary) FormatWithSummary(failures <-chan lint.Failure, config lint.Config, summary func() lint.Summary) (string, error) {
+	report := jsonSummaryReport{
+		Summary:  jsonSummary{Failures: map[lint.Severity]int{}},
+		Failures: []jsonObject{},
+	}
+	for failure := range failures {
+		object := newJSONObject(config, failure)
+		report.Summary.Failures[object.Severity]++
+		report.Failures = append(report.Failures, object)
+	}
+
+	if summary != nil {
+		s := summary()
+		report.Summary.Files = s.Files
+		report.Summary.Rules = s.Rules
+		report.Summary.DurationMs = s.Duration.Milliseconds()
+	}
+
+	result, err := json.Marshal(report)
+	if err != nil {
+		return "", err
+	}
+	return string(result), err
+}
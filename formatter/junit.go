@@ -0,0 +1,121 @@
+package formatter
+
+import (
+	"encoding/xml"
+	"fmt"
+	"sort"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// JUnit is an implementation of the Formatter interface
+// which formats the errors to JUnit XML, thus failures are shown as failed tests by CI systems.
+// Failures of each file are grouped in a test suite, and every failure is a test case.
+type JUnit struct {
+	Metadata lint.FormatterMetadata
+	// SkipWarnings reports the failures of severity warning as skipped test cases instead of failed ones,
+	// it can also be set with the skipWarnings option of the formatter configuration
+	SkipWarnings bool
+}
+
+const junitSkipWarningsOption = "skipWarnings"
+
+// Name returns the name of the formatter
+func (*JUnit) Name() string {
+	return "junit"
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	TestCases []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitFailure `xml:"failure,omitempty"`
+	Skipped   *junitFailure `xml:"skipped,omitempty"`
+}
+
+type junitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// Format formats the failures gotten from the lint.
+func (f *JUnit) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
+	skipWarnings := f.SkipWarnings
+	if value, ok := config.Formatters[f.Name()][junitSkipWarningsOption]; ok {
+		skip, isBool := value.(bool)
+		if !isBool {
+			return "", fmt.Errorf("invalid value %v for the %s option of the %s formatter, expecting a boolean", value, junitSkipWarningsOption, f.Name())
+		}
+		skipWarnings = skipWarnings || skip
+	}
+
+	suites := map[string]*junitTestSuite{}
+	for failure := range failures {
+		filename := failure.GetFilename()
+		suite, ok := suites[filename]
+		if !ok {
+			suite = &junitTestSuite{Name: filename}
+			suites[filename] = suite
+		}
+
+		pos := failure.Position.Start
+		testCase := junitTestCase{
+			Name:      fmt.Sprintf("%s (%d:%d)", failure.RuleName, pos.Line, pos.Column),
+			ClassName: filename,
+		}
+		result := &junitFailure{
+			Message: failure.Failure,
+			Type:    failure.RuleName,
+			Text:    fmt.Sprintf("%v: %s (confidence %v)", pos, failure.Failure, failure.Confidence),
+		}
+
+		suite.Tests++
+		if skipWarnings && severity(config, failure) == lint.SeverityWarning {
+			suite.Skipped++
+			testCase.Skipped = result
+		} else {
+			suite.Failures++
+			testCase.Failure = result
+		}
+		suite.TestCases = append(suite.TestCases, testCase)
+	}
+
+	filenames := make([]string, 0, len(suites))
+	for filename := range suites {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+
+	report := junitTestSuites{Name: "revive"}
+	for _, filename := range filenames {
+		suite := suites[filename]
+		report.Tests += suite.Tests
+		report.Failures += suite.Failures
+		report.Skipped += suite.Skipped
+		report.Suites = append(report.Suites, *suite)
+	}
+
+	out, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(out), nil
+}
@@ -3,12 +3,15 @@ package formatter
 import (
 	"bytes"
 	"encoding/json"
+	"io"
 
 	"github.com/mgechev/revive/lint"
 )
 
 // NDJSON is an implementation of the Formatter interface
 // which formats the errors to NDJSON stream.
+// Each failure is written on its own line as soon as it is received,
+// thus, unlike the JSON formatter, the output has no enclosing array nor aggregate data.
 type NDJSON struct {
 	Metadata lint.FormatterMetadata
 }
@@ -19,17 +22,33 @@ func (*NDJSON) Name() string {
 }
 
 // Format formats the failures gotten from the lint.
-func (*NDJSON) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
+func (f *NDJSON) Format(failures <-chan lint.Failure, config lint.Config) (string, error) {
 	var buf bytes.Buffer
-	enc := json.NewEncoder(&buf)
+	err := f.FormatStream(failures, config, &buf)
+	if err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+// flusher is implemented by buffered writers (e.g. bufio.Writer)
+type flusher interface {
+	Flush() error
+}
+
+// FormatStream writes the failures to w, one JSON object per line, as they are received.
+func (*NDJSON) FormatStream(failures <-chan lint.Failure, config lint.Config, w io.Writer) error {
+	enc := json.NewEncoder(w)
 	for failure := range failures {
-		obj := jsonObject{}
-		obj.Severity = severity(config, failure)
-		obj.Failure = failure
-		err := enc.Encode(obj)
+		err := enc.Encode(newJSONObject(config, failure))
 		if err != nil {
-			return "", err
+			return err
+		}
+		if f, ok := w.(flusher); ok {
+			if err := f.Flush(); err != nil {
+				return err
+			}
 		}
 	}
-	return buf.String(), nil
+	return nil
 }
@@ -3,6 +3,8 @@ package formatter
 import (
 	"bytes"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/chavacava/garif"
@@ -38,38 +40,36 @@ func (*Sarif) Format(failures <-chan lint.Failure, cfg lint.Config) (string, err
 
 type reviveRunLog struct {
 	*garif.LogFile
-	run   *garif.Run
-	rules map[string]lint.RuleConfig
+	run *garif.Run
+	cfg lint.Config
+	// reportedRules is the set of rules already listed in the driver rules
+	reportedRules map[string]bool
 }
 
 func newReviveRunLog(cfg lint.Config) *reviveRunLog {
 	run := garif.NewRun(garif.NewTool(garif.NewDriver("revive").WithInformationUri(reviveSite)))
 	log := garif.NewLogFile([]*garif.Run{run}, garif.Version210)
 
-	reviveLog := &reviveRunLog{
+	return &reviveRunLog{
 		log,
 		run,
-		cfg.Rules,
+		cfg,
+		map[string]bool{},
 	}
-
-	reviveLog.addRules(cfg.Rules)
-
-	return reviveLog
 }
 
-func (l *reviveRunLog) addRules(cfg map[string]lint.RuleConfig) {
-	for name, ruleCfg := range cfg {
-		rule := garif.NewRule(name).WithHelpUri(reviveSite + "/r#" + name)
-		setRuleProperties(rule, ruleCfg)
-		driver := l.run.Tool.Driver
-
-		if driver.Rules == nil {
-			driver.Rules = []*garif.ReportingDescriptor{rule}
-			return
-		}
-
-		driver.Rules = append(driver.Rules, rule)
+// addRule lists, once, the rule of the given failure in the driver rules
+func (l *reviveRunLog) addRule(failure lint.Failure) {
+	name := failure.RuleName
+	if name == "" || l.reportedRules[name] {
+		return
 	}
+	l.reportedRules[name] = true
+
+	rule := garif.NewRule(name).WithHelpUri(reviveSite + "/r#" + name)
+	setRuleProperties(rule, l.cfg.Rules[name], severity(l.cfg, failure))
+	driver := l.run.Tool.Driver
+	driver.Rules = append(driver.Rules, rule)
 }
 
 func (l *reviveRunLog) AddResult(failure lint.Failure) {
@@ -80,20 +80,43 @@ func (l *reviveRunLog) AddResult(failure lint.Failure) {
 		return 0
 	}
 	position := failure.Position
-	filename := position.Start.Filename
+	filename := relativeURI(position.Start.Filename)
 	line := positiveOrZero(position.Start.Line)     // https://docs.oasis-open.org/sarif/sarif/v2.1.0/csprd01/sarif-v2.1.0-csprd01.html#def_line
 	column := positiveOrZero(position.Start.Column) // https://docs.oasis-open.org/sarif/sarif/v2.1.0/csprd01/sarif-v2.1.0-csprd01.html#def_column
 
 	result := garif.NewResult(garif.NewMessageFromText(failure.Failure))
 	location := garif.NewLocation().WithURI(filename).WithLineColumn(line, column)
 	result.Locations = append(result.Locations, location)
+	for i, related := range failure.RelatedInformation {
+		relatedLocation := garif.NewLocation().
+			WithURI(relativeURI(related.Position.Start.Filename)).
+			WithLineColumn(positiveOrZero(related.Position.Start.Line), positiveOrZero(related.Position.Start.Column))
+		relatedLocation.Id = i + 1
+		relatedLocation.Message = garif.NewMessageFromText(related.Message)
+		result.RelatedLocations = append(result.RelatedLocations, relatedLocation)
+	}
 	result.RuleId = failure.RuleName
-	result.Level = garif.ResultLevel(l.rules[failure.RuleName].Severity)
+	result.Level = garif.ResultLevel(severity(l.cfg, failure))
 
+	l.addRule(failure)
 	l.run.Results = append(l.run.Results, result)
 }
 
-func setRuleProperties(sarifRule *garif.ReportingDescriptor, lintRule lint.RuleConfig) {
+// relativeURI returns the given file path as a URI relative to the working directory (the root of the analyzed repository),
+// as expected by code scanning tools
+func relativeURI(filename string) string {
+	if filepath.IsAbs(filename) {
+		if wd, err := os.Getwd(); err == nil {
+			if rel, err := filepath.Rel(wd, filename); err == nil && !strings.HasPrefix(rel, "..") {
+				filename = rel
+			}
+		}
+	}
+
+	return filepath.ToSlash(filename)
+}
+
+func setRuleProperties(sarifRule *garif.ReportingDescriptor, lintRule lint.RuleConfig, severity lint.Severity) {
 	arguments := make([]string, len(lintRule.Arguments))
 	for i, arg := range lintRule.Arguments {
 		arguments[i] = fmt.Sprintf("%+v", arg)
@@ -103,5 +126,5 @@ func setRuleProperties(sarifRule *garif.ReportingDescriptor, lintRule lint.RuleC
 		sarifRule.WithProperties("arguments", strings.Join(arguments, ","))
 	}
 
-	sarifRule.WithProperties("severity", string(lintRule.Severity))
+	sarifRule.WithProperties("severity", string(severity))
 }
@@ -0,0 +1,145 @@
+package lint
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sync"
+)
+
+// ASTCache keeps the syntax trees of the parsed files, keyed by file name and content, so that linting again
+// unchanged files (e.g. from an editor integration) does not parse them again.
+// Only the syntax trees are cached: type information is computed for each linting, from the files of the package
+// being linted, thus it is never stale.
+// An ASTCache is safe for concurrent use and can be shared by linters (see WithASTCache).
+type ASTCache struct {
+	fset       *token.FileSet // the file set the cached files are parsed into, unless the linter has its own
+	maxEntries int
+	entries    *list.List // of *astCacheEntry, the most recently used first
+	index      map[astCacheKey]*list.Element
+	mu         sync.Mutex
+}
+
+type astCacheKey struct {
+	filename string
+	hash     [sha256.Size]byte
+}
+
+type astCacheEntry struct {
+	key     astCacheKey
+	fset    *token.FileSet
+	file    *ast.File
+	content []byte
+}
+
+// NewASTCache creates a cache of at most maxEntries syntax trees; the least recently used trees are evicted first.
+// The cache is unbounded if maxEntries is not positive.
+// Evicted files are removed from the file set of the cache, thus maxEntries should exceed the number of files
+// linted at once to keep the positions of the files being linted.
+func NewASTCache(maxEntries int) *ASTCache {
+	return &ASTCache{
+		fset:       token.NewFileSet(),
+		maxEntries: maxEntries,
+		entries:    list.New(),
+		index:      map[astCacheKey]*list.Element{},
+	}
+}
+
+// WithASTCache makes the linter reuse the syntax trees of the given cache for unchanged files.
+// Unless a file set is given with WithFileSet, the files are parsed into the file set of the cache,
+// which grows with each parsed file until the cache is full; call Clear to release it.
+func WithASTCache(cache *ASTCache) Option {
+	return func(l *Linter) {
+		l.astCache = cache
+	}
+}
+
+// Len returns the number of cached syntax trees.
+func (c *ASTCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.entries.Len()
+}
+
+// Clear removes all the syntax trees from the cache.
+func (c *ASTCache) Clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.fset = token.NewFileSet()
+	c.entries.Init()
+	c.index = map[astCacheKey]*list.Element{}
+}
+
+// fileSet returns the file set into which the files are parsed
+func (c *ASTCache) fileSet() *token.FileSet {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.fset
+}
+
+// parse returns the syntax tree of the given file content, parsing it only if it is not cached for the file set;
+// the returned content is the cached one if any, otherwise the given one.
+// A nil cache always parses.
+func (c *ASTCache) parse(fset *token.FileSet, filename string, content []byte) (*ast.File, []byte, error) {
+	if c == nil {
+		file, err := parser.ParseFile(fset, filename, content, parser.ParseComments)
+		return file, content, err
+	}
+
+	key := astCacheKey{filename: filename, hash: sha256.Sum256(content)}
+	if entry := c.get(key, fset); entry != nil {
+		return entry.file, entry.content, nil
+	}
+
+	// parse without holding the lock, so that files are parsed concurrently
+	file, err := parser.ParseFile(fset, filename, content, parser.ParseComments)
+	if err != nil {
+		return nil, nil, err // invalid files are not cached, their errors are reported each time
+	}
+	c.put(&astCacheEntry{key: key, fset: fset, file: file, content: content})
+
+	return file, content, nil
+}
+
+func (c *ASTCache) get(key astCacheKey, fset *token.FileSet) *astCacheEntry {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	elem, ok := c.index[key]
+	if !ok {
+		return nil
+	}
+	entry := elem.Value.(*astCacheEntry)
+	if entry.fset != fset {
+		return nil // positions of the tree are only meaningful in the file set the tree is parsed into
+	}
+
+	c.entries.MoveToFront(elem)
+	return entry
+}
+
+func (c *ASTCache) put(entry *astCacheEntry) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if elem, ok := c.index[entry.key]; ok {
+		elem.Value = entry
+		c.entries.MoveToFront(elem)
+		return
+	}
+
+	c.index[entry.key] = c.entries.PushFront(entry)
+	for c.maxEntries > 0 && c.entries.Len() > c.maxEntries {
+		oldest := c.entries.Remove(c.entries.Back()).(*astCacheEntry)
+		delete(c.index, oldest.key)
+		if oldest.fset == c.fset {
+			// release the evicted file from the file set of the cache, that otherwise grows with each parsed file
+			c.fset.RemoveFile(c.fset.File(oldest.file.Pos()))
+		}
+	}
+}
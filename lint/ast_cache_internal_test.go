@@ -0,0 +1,24 @@
+package lint
+
+import (
+	"go/token"
+	"testing"
+)
+
+func TestASTCacheEvictionReleasesFiles(t *testing.T) {
+	cache := NewASTCache(2)
+	for _, filename := range []string{"a.go", "b.go", "c.go"} {
+		if _, _, err := cache.parse(cache.fileSet(), filename, []byte("package pkg\n")); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	cache.fileSet().Iterate(func(f *token.File) bool {
+		got = append(got, f.Name())
+		return true
+	})
+	if len(got) != 2 || got[0] != "b.go" || got[1] != "c.go" {
+		t.Fatalf("expected the file set to hold the cached files [b.go c.go], got %v", got)
+	}
+}
@@ -15,6 +15,10 @@ type RuleConfig struct {
 	Exclude []string
 	// excludeFilters - regex-based file filters, initialized from Exclude
 	excludeFilters []*FileFilter
+	// Include - rule-level file includes, TOML related (strings)
+	Include []string
+	// includeFilters - regex-based file filters, initialized from Include
+	includeFilters []*FileFilter
 }
 
 // Initialize - should be called after reading from TOML file
@@ -26,6 +30,13 @@ func (rc *RuleConfig) Initialize() error {
 		}
 		rc.excludeFilters = append(rc.excludeFilters, ff)
 	}
+	for _, f := range rc.Include {
+		ff, err := ParseFileFilter(f)
+		if err != nil {
+			return err
+		}
+		rc.includeFilters = append(rc.includeFilters, ff)
+	}
 	return nil
 }
 
@@ -42,6 +53,20 @@ func (rc *RuleConfig) MustExclude(name string) bool {
 	return false
 }
 
+// MustInclude - checks if given filename `name` must be included
+// (all files are included if there are no include filters)
+func (rc *RuleConfig) MustInclude(name string) bool {
+	if len(rc.includeFilters) == 0 {
+		return true
+	}
+	for _, include := range rc.includeFilters {
+		if include.MatchFileName(name) {
+			return true
+		}
+	}
+	return false
+}
+
 // DirectiveConfig is type used for the linter directive configuration.
 type DirectiveConfig struct {
 	Severity Severity
@@ -50,6 +75,18 @@ type DirectiveConfig struct {
 // DirectivesConfig defines the config for all directives.
 type DirectivesConfig = map[string]DirectiveConfig
 
+// FormatterOptions are the options of a formatter, by name.
+type FormatterOptions = map[string]any
+
+// FormattersConfig defines the options of the formatters.
+type FormattersConfig = map[string]FormatterOptions
+
+// ConfidenceSeverity is a threshold of confidence above which failures have the given severity.
+type ConfidenceSeverity struct {
+	Min      float64  `toml:"min"`
+	Severity Severity `toml:"severity"`
+}
+
 // Config defines the config of the linter.
 type Config struct {
 	IgnoreGeneratedHeader bool `toml:"ignoreGeneratedHeader"`
@@ -61,4 +98,66 @@ type Config struct {
 	WarningCode           int              `toml:"warningCode"`
 	Directives            DirectivesConfig `toml:"directive"`
 	Exclude               []string         `toml:"exclude"`
+	// ConfidenceSeverity - severities of the failures, by confidence, of the rules without an explicit severity
+	ConfidenceSeverity []ConfidenceSeverity `toml:"confidenceSeverity"`
+	// FailOn - minimum severity of the failures setting the exit code, failures of any severity set it if empty
+	FailOn Severity `toml:"failOn"`
+	// RuleDocsURL - base URL of the rules documentation, the rule name is appended to it by formatters linking to the rules
+	RuleDocsURL string `toml:"ruleDocsURL"`
+	// MaxWorkers - maximum number of files linted at the same time, defaults to GOMAXPROCS
+	MaxWorkers int `toml:"maxWorkers"`
+	// Formatters - options of the formatters, by formatter name
+	Formatters FormattersConfig `toml:"formatter"`
+	// DiscoverConfigs - merge the configuration files found in the directories of the linted files
+	DiscoverConfigs bool `toml:"discoverConfigs"`
+	// RegisteredRules - names of all the rules available to the linter, enabled or not, checked by the unknown-rule directive
+	RegisteredRules []string `toml:"-"`
+	// ConfigForFile - if set, yields the rules and the configuration to apply to the given file
+	ConfigForFile func(filename string) ([]Rule, Config, error) `toml:"-"`
+}
+
+// FailureSeverity returns the severity of the failure, that is by order of precedence:
+// the severity configured for its rule (or directive), the severity of the highest
+// confidence threshold reached by the failure, or the global severity.
+// If ConfigForFile is set, the configuration of the file of the failure is used.
+func (c *Config) FailureSeverity(failure Failure) Severity {
+	if c.ConfigForFile != nil && failure.GetFilename() != "" {
+		if _, fileConfig, err := c.ConfigForFile(failure.GetFilename()); err == nil {
+			fileConfig.ConfigForFile = nil
+			c = &fileConfig
+		}
+	}
+
+	var result Severity
+	configured := false
+	if ruleConfig, ok := c.Rules[failure.RuleName]; ok {
+		result, configured = ruleConfig.Severity, true
+	} else if directiveConfig, ok := c.Directives[failure.RuleName]; ok {
+		result, configured = directiveConfig.Severity, true
+	}
+
+	if result == "" {
+		result = confidenceSeverity(c.ConfidenceSeverity, failure.Confidence)
+	}
+	if result == "" && configured {
+		result = c.Severity
+	}
+
+	if result == SeverityError {
+		return SeverityError
+	}
+	return SeverityWarning
+}
+
+// confidenceSeverity returns the severity of the highest threshold reached by the given confidence, if any.
+func confidenceSeverity(thresholds []ConfidenceSeverity, confidence float64) Severity {
+	var result Severity
+	highest := -1.0
+	for _, threshold := range thresholds {
+		if confidence >= threshold.Min && threshold.Min > highest {
+			result, highest = threshold.Severity, threshold.Min
+		}
+	}
+
+	return result
 }
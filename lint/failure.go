@@ -1,8 +1,10 @@
 package lint
 
 import (
+	"bytes"
 	"go/ast"
 	"go/token"
+	"sync"
 )
 
 const (
@@ -21,6 +23,15 @@ type FailurePosition struct {
 	End   token.Position
 }
 
+// RelatedInformation is a position, other than the one of a failure, that helps to understand the failure
+// (e.g. the declaration shadowed by the declaration reported by the failure).
+type RelatedInformation struct {
+	Position FailurePosition
+	Message  string
+	// Node, if set, determines the position
+	Node ast.Node `json:"-"`
+}
+
 // Failure defines a struct for a linting failure.
 type Failure struct {
 	Failure    string
@@ -31,9 +42,58 @@ type Failure struct {
 	Confidence float64
 	// For future use
 	ReplacementLine string
+	// RelatedInformation lists the positions related to the failure, formatters not supporting them ignore them
+	RelatedInformation []RelatedInformation `json:",omitempty"`
+	// source gives access to the lines of the linted file (nil for failures not related to a file content)
+	source *sourceLines
 }
 
 // GetFilename returns the filename.
 func (f *Failure) GetFilename() string {
 	return f.Position.Start.Filename
 }
+
+// SourceLine returns the source line where the failure starts, without its trailing newline.
+// It returns false if the source of the failure is not available.
+func (f *Failure) SourceLine() (string, bool) {
+	if f.source == nil {
+		return "", false
+	}
+
+	return f.source.line(f.Position.Start.Line)
+}
+
+// sourceLines is an index of the lines of a file content, computed on first use
+// and shared by the failures of the file.
+type sourceLines struct {
+	content []byte
+	once    sync.Once
+	starts  []int // offsets of the beginning of each line
+}
+
+func newSourceLines(content []byte) *sourceLines {
+	return &sourceLines{content: content}
+}
+
+// line returns the given (1-based) line without its trailing newline
+func (s *sourceLines) line(n int) (string, bool) {
+	s.once.Do(func() {
+		s.starts = []int{0}
+		for i, b := range s.content {
+			if b == '\n' {
+				s.starts = append(s.starts, i+1)
+			}
+		}
+	})
+
+	if n < 1 || n > len(s.starts) {
+		return "", false
+	}
+
+	end := len(s.content)
+	if n < len(s.starts) {
+		end = s.starts[n] - 1
+	}
+
+	return string(bytes.TrimSuffix(s.content[s.starts[n-1]:end], []byte("\r"))), true
+}
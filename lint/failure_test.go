@@ -0,0 +1,52 @@
+package lint_test
+
+import (
+	"go/ast"
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+)
+
+type funcNameRule struct{}
+
+func (funcNameRule) Name() string { return "func-name" }
+
+func (funcNameRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			failures = append(failures, lint.Failure{Node: fn.Name, Failure: fn.Name.Name})
+		}
+	}
+	return failures
+}
+
+func TestFailureSourceLine(t *testing.T) {
+	src := "package pkg\n\n// doc\nfunc\tfoo() {}\r\n"
+	l := lint.New(func(string) ([]byte, error) { return []byte(src), nil }, 0)
+
+	failures, err := l.Lint([][]string{{"foo.go"}}, []lint.Rule{funcNameRule{}}, lint.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got []lint.Failure
+	for f := range failures {
+		got = append(got, f)
+	}
+	if len(got) != 1 {
+		t.Fatalf("expected 1 failure, got %d", len(got))
+	}
+
+	line, ok := got[0].SourceLine()
+	if !ok {
+		t.Fatal("expected the source line to be available")
+	}
+	if want := "func\tfoo() {}"; line != want {
+		t.Errorf("expected source line %q, got %q", want, line)
+	}
+
+	if _, ok := (&lint.Failure{}).SourceLine(); ok {
+		t.Error("expected no source line for a failure without file")
+	}
+}
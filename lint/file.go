@@ -2,14 +2,16 @@ package lint
 
 import (
 	"bytes"
+	"fmt"
 	"go/ast"
-	"go/parser"
 	"go/printer"
 	"go/token"
 	"go/types"
 	"math"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 )
 
 // File abstraction used for representing files.
@@ -18,6 +20,7 @@ type File struct {
 	Pkg     *Package
 	content []byte
 	AST     *ast.File
+	lines   *sourceLines
 }
 
 // IsTest returns if the file contains tests.
@@ -30,7 +33,7 @@ func (f *File) Content() []byte {
 
 // NewFile creates a new file
 func NewFile(name string, content []byte, pkg *Package) (*File, error) {
-	f, err := parser.ParseFile(pkg.fset, name, content, parser.ParseComments)
+	f, content, err := pkg.astCache.parse(pkg.fset, name, content)
 	if err != nil {
 		return nil, err
 	}
@@ -39,6 +42,7 @@ func NewFile(name string, content []byte, pkg *Package) (*File, error) {
 		content: content,
 		Pkg:     pkg,
 		AST:     f,
+		lines:   newSourceLines(content),
 	}, nil
 }
 
@@ -97,15 +101,33 @@ func (f *File) isMain() bool {
 const directiveSpecifyDisableReason = "specify-disable-reason"
 
 func (f *File) lint(rules []Rule, config Config, failures chan Failure) {
+	if config.ConfigForFile != nil {
+		var err error
+		rules, config, err = config.ConfigForFile(f.Name)
+		if err != nil {
+			failures <- Failure{
+				Confidence: 1,
+				Failure:    fmt.Sprintf("cannot configure the linting of %s: %v", f.Name, err),
+				Category:   "validity",
+				Position:   FailurePosition{Start: token.Position{Filename: f.Name}},
+			}
+			return
+		}
+	}
+
 	rulesConfig := config.Rules
 	_, mustSpecifyDisableReason := config.Directives[directiveSpecifyDisableReason]
-	disabledIntervals := f.disabledIntervals(rules, mustSpecifyDisableReason, failures)
+	var knownRules map[string]bool
+	if _, mustCheckRuleNames := config.Directives[directiveUnknownRule]; mustCheckRuleNames {
+		knownRules = knownRuleNames(rules, config)
+	}
+	disabledIntervals := f.disabledIntervals(rules, mustSpecifyDisableReason, knownRules, failures)
 	for _, currentRule := range rules {
 		ruleConfig := rulesConfig[currentRule.Name()]
-		if ruleConfig.MustExclude(f.Name) {
+		if !ruleConfig.MustInclude(f.Name) || ruleConfig.MustExclude(f.Name) {
 			continue
 		}
-		currentFailures := currentRule.Apply(f, ruleConfig.Arguments)
+		currentFailures := applyRule(currentRule, f, ruleConfig.Arguments)
 		for idx, failure := range currentFailures {
 			if failure.RuleName == "" {
 				failure.RuleName = currentRule.Name()
@@ -113,6 +135,12 @@ func (f *File) lint(rules []Rule, config Config, failures chan Failure) {
 			if failure.Node != nil {
 				failure.Position = ToFailurePosition(failure.Node.Pos(), failure.Node.End(), f)
 			}
+			for i, related := range failure.RelatedInformation {
+				if related.Node != nil {
+					failure.RelatedInformation[i].Position = ToFailurePosition(related.Node.Pos(), related.Node.End(), f)
+				}
+			}
+			failure.source = f.lines
 			currentFailures[idx] = failure
 		}
 		currentFailures = f.filterFailures(currentFailures, disabledIntervals)
@@ -130,7 +158,7 @@ type enableDisableConfig struct {
 }
 
 const (
-	directiveRE  = `^//[\s]*revive:(enable|disable)(?:-(line|next-line))?(?::([^\s]+))?[\s]*(?: (.+))?$`
+	directiveRE  = `^//[\s]*revive:(enable|disable)(?:-(line|next-line|block))?(?::([^\s]+))?[\s]*(?: (.+))?$`
 	directivePos = 1
 	modifierPos  = 2
 	rulesPos     = 3
@@ -139,8 +167,14 @@ const (
 
 var re = regexp.MustCompile(directiveRE)
 
-func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, failures chan Failure) disabledIntervalsMap {
+// disabledIntervals returns the intervals of lines where the rules are disabled by directives;
+// if knownRules is not nil, directives referring to other rules are reported
+func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, knownRules map[string]bool, failures chan Failure) disabledIntervalsMap {
 	enabledDisabledRulesMap := make(map[string][]enableDisableConfig)
+	// intervals of block-scoped disabling directives, kept apart because they can nest
+	blockIntervals := make(map[string][]DisabledInterval)
+	// line of the first enabling directive of each rule, it ends the disabling by package-level directives
+	firstEnabling := make(map[string]int)
 
 	getEnabledDisabledIntervals := func() disabledIntervalsMap {
 		result := make(disabledIntervalsMap)
@@ -168,6 +202,10 @@ func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, fa
 			result[ruleName] = ruleResult
 		}
 
+		for ruleName, intervals := range blockIntervals {
+			result[ruleName] = append(result[ruleName], intervals...)
+		}
+
 		return result
 	}
 
@@ -211,18 +249,32 @@ func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, fa
 			if len(match) == 0 {
 				continue
 			}
-			ruleNames := []string{}
-			tempNames := strings.Split(match[rulesPos], ",")
+			ruleNames := directiveRuleNames(match)
 
-			for _, name := range tempNames {
-				name = strings.Trim(name, "\n")
-				if len(name) > 0 {
-					ruleNames = append(ruleNames, name)
+			reason, until, err := parseDirectiveExpiry(match[reasonPos])
+			if err != nil {
+				failures <- Failure{
+					Confidence: 1,
+					RuleName:   directiveExpiredDisable,
+					Failure:    fmt.Sprintf("invalid expiry date of lint disabling: %v", err),
+					Position:   ToFailurePosition(c.Pos(), c.End(), f),
+					Node:       c,
 				}
+				continue // skip this linter disabling directive
+			}
+			if isExpired(until) {
+				failures <- Failure{
+					Confidence: 1,
+					RuleName:   directiveExpiredDisable,
+					Failure:    fmt.Sprintf("lint disabling expired on %s, fix the disabled failures or postpone the expiry date", until.Format(directiveDateLayout)),
+					Position:   ToFailurePosition(c.Pos(), c.End(), f),
+					Node:       c,
+				}
+				continue // the directive is ignored once expired
 			}
 
 			mustCheckDisablingReason := mustSpecifyDisableReason && match[directivePos] == "disable"
-			if mustCheckDisablingReason && strings.Trim(match[reasonPos], " ") == "" {
+			if mustCheckDisablingReason && strings.Trim(reason, " ") == "" {
 				failures <- Failure{
 					Confidence: 1,
 					RuleName:   directiveSpecifyDisableReason,
@@ -233,6 +285,18 @@ func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, fa
 				continue // skip this linter disabling directive
 			}
 
+			for _, name := range ruleNames {
+				if knownRules != nil && !knownRules[name] {
+					failures <- Failure{
+						Confidence: 1,
+						RuleName:   directiveUnknownRule,
+						Failure:    fmt.Sprintf("lint directive refers to unknown rule %s", name),
+						Position:   ToFailurePosition(c.Pos(), c.End(), f),
+						Node:       c,
+					}
+				}
+			}
+
 			// TODO: optimize
 			if len(ruleNames) == 0 {
 				for _, rule := range rules {
@@ -240,7 +304,30 @@ func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, fa
 				}
 			}
 
-			handleRules(filename, match[modifierPos], match[directivePos] == "enable", line, ruleNames)
+			if match[modifierPos] == "block" {
+				block := f.enclosingBlock(c.Pos())
+				if block == nil || match[directivePos] == "enable" {
+					continue // block-scoped directives only disable rules within a block
+				}
+				for _, name := range ruleNames {
+					blockIntervals[name] = append(blockIntervals[name], DisabledInterval{
+						RuleName: name,
+						From:     token.Position{Filename: filename, Line: line},
+						To:       token.Position{Filename: filename, Line: f.ToPosition(block.End()).Line},
+					})
+				}
+				continue
+			}
+
+			isEnabled := match[directivePos] == "enable"
+			if isEnabled && match[modifierPos] == "" {
+				for _, name := range ruleNames {
+					if _, ok := firstEnabling[name]; !ok {
+						firstEnabling[name] = line
+					}
+				}
+			}
+			handleRules(filename, match[modifierPos], isEnabled, line, ruleNames)
 		}
 	}
 
@@ -249,7 +336,140 @@ func (f *File) disabledIntervals(rules []Rule, mustSpecifyDisableReason bool, fa
 		handleComment(f.Name, c, f.ToPosition(c.End()).Line)
 	}
 
-	return getEnabledDisabledIntervals()
+	result := getEnabledDisabledIntervals()
+	for name, interval := range f.packageDisabledIntervals(rules, mustSpecifyDisableReason, firstEnabling) {
+		result[name] = append(result[name], interval)
+	}
+
+	return result
+}
+
+// sequentialRulesMutex prevents sequential rules from being applied concurrently
+var sequentialRulesMutex sync.Mutex
+
+// applyRule applies the rule to the file, one file at a time if the rule is not safe for concurrent use
+func applyRule(rule Rule, f *File, arguments Arguments) []Failure {
+	if r, ok := rule.(SequentialRule); ok && r.IsSequential() {
+		sequentialRulesMutex.Lock()
+		defer sequentialRulesMutex.Unlock()
+	}
+
+	return rule.Apply(f, arguments)
+}
+
+// enclosingBlock returns the innermost block statement containing the given position, if any.
+func (f *File) enclosingBlock(pos token.Pos) *ast.BlockStmt {
+	var result *ast.BlockStmt
+	ast.Inspect(f.AST, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false // the position is not within this node
+		}
+		if block, ok := n.(*ast.BlockStmt); ok && pos > block.Lbrace {
+			result = block
+		}
+		return true
+	})
+
+	return result
+}
+
+// knownRuleNames returns the names of the rules directives can refer to: the registered rules,
+// whether they are enabled or not, and the directives
+func knownRuleNames(rules []Rule, config Config) map[string]bool {
+	result := map[string]bool{
+		directiveSpecifyDisableReason: true,
+		directiveExpiredDisable:       true,
+		directiveUnknownRule:          true,
+	}
+	for _, r := range rules {
+		result[r.Name()] = true
+	}
+	for _, name := range config.RegisteredRules {
+		result[name] = true
+	}
+
+	return result
+}
+
+const (
+	directiveExpiredDisable = "expired-disable"
+	directiveUnknownRule    = "unknown-rule"
+	directiveUntilPrefix    = "until="
+	directiveDateLayout     = "2006-01-02"
+)
+
+// parseDirectiveExpiry extracts, from the reason of a directive, the optional leading expiry date (until=YYYY-MM-DD).
+// It returns the reason without the expiry date, and the zero time if no expiry date is set.
+func parseDirectiveExpiry(reason string) (string, time.Time, error) {
+	reason = strings.TrimLeft(reason, " ")
+	if !strings.HasPrefix(reason, directiveUntilPrefix) {
+		return reason, time.Time{}, nil
+	}
+
+	date, rest, _ := strings.Cut(strings.TrimPrefix(reason, directiveUntilPrefix), " ")
+	until, err := time.Parse(directiveDateLayout, date)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("expecting %sYYYY-MM-DD, got %q", directiveUntilPrefix, directiveUntilPrefix+date)
+	}
+
+	return rest, until, nil
+}
+
+// packageDisabledIntervals returns the intervals of the file disabled by the package-level directives of the other files of the package.
+// Such directives apply from the top of the file until the first directive of the file enabling the rule, if any.
+func (f *File) packageDisabledIntervals(rules []Rule, mustSpecifyDisableReason bool, firstEnabling map[string]int) map[string]DisabledInterval {
+	result := map[string]DisabledInterval{}
+	if f.Pkg == nil {
+		return result
+	}
+
+	for _, d := range f.Pkg.directives {
+		if d.file == f {
+			continue // handled as the other directives of the file
+		}
+		reason, until, err := parseDirectiveExpiry(d.reason)
+		if err != nil || isExpired(until) || (mustSpecifyDisableReason && strings.Trim(reason, " ") == "") {
+			continue // ignored, the file holding the directive reports why
+		}
+
+		ruleNames := d.ruleNames
+		if len(ruleNames) == 0 {
+			for _, rule := range rules {
+				ruleNames = append(ruleNames, rule.Name())
+			}
+		}
+		for _, name := range ruleNames {
+			to, ok := firstEnabling[name]
+			if !ok {
+				to = math.MaxInt32
+			}
+			result[name] = DisabledInterval{
+				RuleName: name,
+				From:     token.Position{Filename: f.Name, Line: 0},
+				To:       token.Position{Filename: f.Name, Line: to},
+			}
+		}
+	}
+
+	return result
+}
+
+// directiveRuleNames returns the rule names listed in a matched directive, if any.
+func directiveRuleNames(match []string) []string {
+	ruleNames := []string{}
+	for _, name := range strings.Split(match[rulesPos], ",") {
+		name = strings.Trim(name, "\n")
+		if len(name) > 0 {
+			ruleNames = append(ruleNames, name)
+		}
+	}
+
+	return ruleNames
+}
+
+// isExpired returns true if the given expiry date of a directive is past, the directive being honored until the end of the day.
+func isExpired(until time.Time) bool {
+	return !until.IsZero() && !time.Now().Before(until.AddDate(0, 0, 1))
 }
 
 func (File) filterFailures(failures []Failure, disabledIntervals disabledIntervalsMap) []Failure {
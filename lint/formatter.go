@@ -1,5 +1,10 @@
 package lint
 
+import (
+	"io"
+	"time"
+)
+
 // FormatterMetadata configuration of a formatter
 type FormatterMetadata struct {
 	Name        string
@@ -12,3 +17,25 @@ type Formatter interface {
 	Format(<-chan Failure, Config) (string, error)
 	Name() string
 }
+
+// Summary gives statistics on a linting.
+type Summary struct {
+	Files    int              // number of linted files
+	Rules    int              // number of applied rules
+	Duration time.Duration    // duration of the linting
+	Failures map[Severity]int // number of reported failures by severity
+}
+
+// SummaryFormatter is implemented by formatters reporting a summary of the linting along with the failures.
+type SummaryFormatter interface {
+	Formatter
+	// FormatWithSummary formats the failures as Format does; the summary is complete once the failures channel is closed.
+	FormatWithSummary(failures <-chan Failure, config Config, summary func() Summary) (string, error)
+}
+
+// StreamFormatter is implemented by formatters able to output each failure as soon as it is received.
+type StreamFormatter interface {
+	Formatter
+	// FormatStream writes the failures to w as they are received; it returns once the failures channel is closed.
+	FormatStream(failures <-chan Failure, config Config, w io.Writer) error
+}
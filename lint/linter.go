@@ -5,10 +5,13 @@ import (
 	"bytes"
 	"fmt"
 	"go/token"
+	"io"
 	"os"
 	"regexp"
+	"runtime"
 	"strconv"
 	"sync"
+	"sync/atomic"
 )
 
 // ReadFile defines an abstraction for reading files.
@@ -20,18 +23,43 @@ type disabledIntervalsMap = map[string][]DisabledInterval
 type Linter struct {
 	reader         ReadFile
 	fileReadTokens chan struct{}
+	fset           *token.FileSet // shared by all the linted packages, if set
+	astCache       *ASTCache      // syntax trees of the already parsed files, if set
+	filesLinted    *int64
+}
+
+// Option configures a Linter.
+type Option func(*Linter)
+
+// WithFileSet makes the linter parse the files into the given file set instead of
+// a new file set per package. It allows callers running other tools on the same sources
+// to share the positions of the parsed files.
+func WithFileSet(fset *token.FileSet) Option {
+	return func(l *Linter) {
+		l.fset = fset
+	}
 }
 
 // New creates a new Linter
-func New(reader ReadFile, maxOpenFiles int) Linter {
+func New(reader ReadFile, maxOpenFiles int, options ...Option) Linter {
 	var fileReadTokens chan struct{}
 	if maxOpenFiles > 0 {
 		fileReadTokens = make(chan struct{}, maxOpenFiles)
 	}
-	return Linter{
+	l := Linter{
 		reader:         reader,
 		fileReadTokens: fileReadTokens,
+		filesLinted:    new(int64),
+	}
+	for _, option := range options {
+		option(&l)
 	}
+	return l
+}
+
+// FilesLinted returns the number of files linted so far, the count is final once the failures channel is closed.
+func (l *Linter) FilesLinted() int {
+	return int(atomic.LoadInt64(l.filesLinted))
 }
 
 func (l Linter) readFile(path string) (result []byte, err error) {
@@ -56,12 +84,13 @@ var (
 // Lint lints a set of files with the specified rule.
 func (l *Linter) Lint(packages [][]string, ruleSet []Rule, config Config) (<-chan Failure, error) {
 	failures := make(chan Failure)
+	workerTokens := newWorkerTokens(config)
 
 	var wg sync.WaitGroup
 	for _, pkg := range packages {
 		wg.Add(1)
 		go func(pkg []string) {
-			if err := l.lintPackage(pkg, ruleSet, config, failures); err != nil {
+			if err := l.lintPackage(pkg, ruleSet, config, failures, workerTokens); err != nil {
 				fmt.Fprintln(os.Stderr, err)
 				os.Exit(1)
 			}
@@ -77,37 +106,90 @@ func (l *Linter) Lint(packages [][]string, ruleSet []Rule, config Config) (<-cha
 	return failures, nil
 }
 
-func (l *Linter) lintPackage(filenames []string, ruleSet []Rule, config Config, failures chan Failure) error {
-	pkg := &Package{
-		fset:  token.NewFileSet(),
-		files: map[string]*File{},
+// LintReader lints, with the specified rules, the content read from reader as if it were the file named filename.
+// The file system is not accessed, thus unsaved contents can be linted; filename still determines, for example, if the file is a test.
+func (l *Linter) LintReader(filename string, reader io.Reader, ruleSet []Rule, config Config) (<-chan Failure, error) {
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
 	}
+
+	failures := make(chan Failure)
+
+	go func() {
+		defer close(failures)
+
+		pkg := l.newPackage()
+		addFile(pkg, filename, content, config, failures)
+		if len(pkg.files) > 0 {
+			atomic.AddInt64(l.filesLinted, int64(len(pkg.files)))
+			pkg.lint(ruleSet, config, failures, newWorkerTokens(config))
+		}
+	}()
+
+	return failures, nil
+}
+
+func (l *Linter) lintPackage(filenames []string, ruleSet []Rule, config Config, failures chan Failure, workerTokens chan struct{}) error {
+	pkg := l.newPackage()
 	for _, filename := range filenames {
 		content, err := l.readFile(filename)
 		if err != nil {
 			return err
 		}
-		if !config.IgnoreGeneratedHeader && isGenerated(content) {
-			continue
-		}
-
-		file, err := NewFile(filename, content, pkg)
-		if err != nil {
-			addInvalidFileFailure(filename, err.Error(), failures)
-			continue
-		}
-		pkg.files[filename] = file
+		addFile(pkg, filename, content, config, failures)
 	}
 
 	if len(pkg.files) == 0 {
 		return nil
 	}
 
-	pkg.lint(ruleSet, config, failures)
+	atomic.AddInt64(l.filesLinted, int64(len(pkg.files)))
+	pkg.lint(ruleSet, config, failures, workerTokens)
 
 	return nil
 }
 
+// newWorkerTokens returns the tokens bounding the number of files linted at the same time:
+// config.MaxWorkers if set, GOMAXPROCS otherwise
+func newWorkerTokens(config Config) chan struct{} {
+	workers := config.MaxWorkers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	return make(chan struct{}, workers)
+}
+
+func (l *Linter) newPackage() *Package {
+	fset := l.fset
+	switch {
+	case fset != nil:
+	case l.astCache != nil:
+		fset = l.astCache.fileSet() // cached trees are only reused for the file set they are parsed into
+	default:
+		fset = token.NewFileSet()
+	}
+	return &Package{
+		fset:     fset,
+		files:    map[string]*File{},
+		astCache: l.astCache,
+	}
+}
+
+// addFile adds to the package the file with the given name and content, unless the file is generated or invalid
+func addFile(pkg *Package, filename string, content []byte, config Config, failures chan Failure) {
+	if !config.IgnoreGeneratedHeader && isGenerated(content) {
+		return
+	}
+
+	file, err := NewFile(filename, content, pkg)
+	if err != nil {
+		addInvalidFileFailure(filename, err.Error(), failures)
+		return
+	}
+	pkg.files[filename] = file
+}
+
 // isGenerated reports whether the source file is generated code
 // according the rules from https://golang.org/s/generatedcode.
 // This is inherited from the original go lint.
@@ -0,0 +1,335 @@
+package lint_test
+
+import (
+	"errors"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/mgechev/revive/lint"
+)
+
+type testFileRule struct{}
+
+func (testFileRule) Name() string { return "test-file" }
+
+func (testFileRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	if !file.IsTest() {
+		return nil
+	}
+	return []lint.Failure{{Node: file.AST.Name, Failure: "test file", Confidence: 1}}
+}
+
+func TestLintReader(t *testing.T) {
+	l := lint.New(func(string) ([]byte, error) { return nil, errors.New("the file system must not be read") }, 0)
+	rules := []lint.Rule{funcNameRule{}, testFileRule{}}
+
+	tt := map[string]struct {
+		filename string
+		src      string
+		want     []string
+	}{
+		"regular file": {
+			filename: "buffer.go",
+			src:      "package pkg\n\nfunc foo() {}\n",
+			want:     []string{"foo"},
+		},
+		"test file": {
+			filename: "buffer_test.go",
+			src:      "package pkg\n\nfunc TestFoo() {}\n",
+			want:     []string{"TestFoo", "test file"},
+		},
+		"invalid file": {
+			filename: "buffer.go",
+			src:      "package pkg\n\nfunc foo() {\n",
+			want:     []string{"invalid file buffer.go"},
+		},
+		"generated file": {
+			filename: "buffer.go",
+			src:      "// Code generated by hand. DO NOT EDIT.\n\npackage pkg\n\nfunc foo() {}\n",
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			failures, err := l.LintReader(tc.filename, strings.NewReader(tc.src), rules, lint.Config{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for f := range failures {
+				got = append(got, f.Failure)
+			}
+
+			if len(got) != len(tc.want) {
+				t.Fatalf("expected failures %q, got %q", tc.want, got)
+			}
+			for _, want := range tc.want {
+				found := false
+				for _, g := range got {
+					found = found || strings.HasPrefix(g, want)
+				}
+				if !found {
+					t.Fatalf("expected failures %q, got %q", tc.want, got)
+				}
+			}
+		})
+	}
+}
+
+func TestUnknownRuleDirective(t *testing.T) {
+	src := "package pkg\n\n//revive:disable:func-name\n//revive:disable:exported\n//revive:disable:var-namming\nfunc foo() {}\n"
+	tt := map[string]struct {
+		directives lint.DirectivesConfig
+		want       []string
+	}{
+		"not configured": {},
+		"configured": {
+			directives: lint.DirectivesConfig{"unknown-rule": {}},
+			want:       []string{"lint directive refers to unknown rule var-namming"},
+		},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			l := lint.New(func(string) ([]byte, error) { return nil, errors.New("the file system must not be read") }, 0)
+			config := lint.Config{Directives: tc.directives, RegisteredRules: []string{"func-name", "exported"}}
+			failures, err := l.LintReader("a.go", strings.NewReader(src), []lint.Rule{funcNameRule{}}, config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for f := range failures {
+				got = append(got, f.Failure)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.want, ",") {
+				t.Fatalf("expected failures %q, got %q", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLintWithFileSet(t *testing.T) {
+	fset := token.NewFileSet()
+	l := lint.New(func(string) ([]byte, error) { return []byte("package pkg\n\nfunc foo() {}\n"), nil }, 0, lint.WithFileSet(fset))
+
+	failures, err := l.Lint([][]string{{"a.go"}, {"b.go"}}, []lint.Rule{funcNameRule{}}, lint.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	for f := range failures {
+		count++
+		if f.Position.Start.Line != 3 || f.Position.Start.Column != 6 {
+			t.Errorf("expected failure at line 3, column 6 of %s, got %v", f.Position.Start.Filename, f.Position.Start)
+		}
+	}
+	if count != 2 {
+		t.Fatalf("expected 2 failures, got %d", count)
+	}
+
+	var parsed []string
+	fset.Iterate(func(f *token.File) bool {
+		parsed = append(parsed, f.Name())
+		return true
+	})
+	sort.Strings(parsed)
+	if strings.Join(parsed, ",") != "a.go,b.go" {
+		t.Fatalf("expected the files to be parsed into the shared file set, got %q", parsed)
+	}
+}
+
+// resolvedRule reports, for each call of the file, if the called function is resolved by the type checker
+type resolvedRule struct {
+	parsed map[string]*ast.File
+	sync.Mutex
+}
+
+func (*resolvedRule) Name() string { return "resolved" }
+
+func (r *resolvedRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	r.Lock()
+	r.parsed[file.Name] = file.AST
+	r.Unlock()
+
+	file.Pkg.TypeCheck()
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok {
+			msg := "unresolved"
+			if file.Pkg.TypesInfo().Uses[call.Fun.(*ast.Ident)] != nil {
+				msg = "resolved"
+			}
+			failures = append(failures, lint.Failure{Node: call, Failure: msg, Confidence: 1})
+		}
+		return true
+	})
+	return failures
+}
+
+func TestLintWithASTCache(t *testing.T) {
+	files := map[string]string{
+		"a.go": "package pkg\n\nfunc foo() { helper() }\n",
+		"b.go": "package pkg\n\nfunc helper() {}\n",
+	}
+	cache := lint.NewASTCache(0)
+	lintPackage := func(filenames ...string) (*resolvedRule, []string) {
+		rule := &resolvedRule{parsed: map[string]*ast.File{}}
+		// a new linter for each linting, as revivelib does
+		l := lint.New(func(filename string) ([]byte, error) { return []byte(files[filename]), nil }, 0, lint.WithASTCache(cache))
+		failures, err := l.Lint([][]string{filenames}, []lint.Rule{rule}, lint.Config{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var got []string
+		for f := range failures {
+			got = append(got, f.Failure)
+		}
+		return rule, got
+	}
+
+	first, got := lintPackage("a.go", "b.go")
+	if strings.Join(got, ",") != "resolved" {
+		t.Fatalf("expected the call to be resolved, got %q", got)
+	}
+	if cache.Len() != 2 {
+		t.Fatalf("expected 2 cached files, got %d", cache.Len())
+	}
+
+	// the package no longer has b.go: the cached tree of a.go is reused, but type information must be computed again
+	second, got := lintPackage("a.go")
+	if second.parsed["a.go"] != first.parsed["a.go"] {
+		t.Fatal("expected the syntax tree of the unchanged file to be reused")
+	}
+	if strings.Join(got, ",") != "unresolved" {
+		t.Fatalf("expected the call to be unresolved, got %q", got)
+	}
+
+	files["a.go"] = "package pkg\n\nfunc foo() { helper(); helper() }\n"
+	third, got := lintPackage("a.go", "b.go")
+	if third.parsed["a.go"] == first.parsed["a.go"] {
+		t.Fatal("expected the changed file to be parsed again")
+	}
+	if third.parsed["b.go"] != first.parsed["b.go"] {
+		t.Fatal("expected the syntax tree of the unchanged file to be reused")
+	}
+	if strings.Join(got, ",") != "resolved,resolved" {
+		t.Fatalf("expected the calls to be resolved, got %q", got)
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 {
+		t.Fatalf("expected an empty cache, got %d files", cache.Len())
+	}
+}
+
+func TestASTCacheEviction(t *testing.T) {
+	cache := lint.NewASTCache(2)
+	l := lint.New(func(string) ([]byte, error) { return []byte("package pkg\n"), nil }, 0, lint.WithASTCache(cache))
+
+	failures, err := l.Lint([][]string{{"a.go", "b.go", "c.go"}}, []lint.Rule{funcNameRule{}}, lint.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range failures {
+	}
+
+	if cache.Len() != 2 {
+		t.Fatalf("expected the cache to be bounded to 2 files, got %d", cache.Len())
+	}
+}
+
+// concurrencyRule records the maximum number of files it is applied to at the same time
+type concurrencyRule struct {
+	sequential bool
+	running    int32
+	max        int32
+	sync.Mutex
+}
+
+func (*concurrencyRule) Name() string { return "concurrency" }
+
+func (r *concurrencyRule) IsSequential() bool { return r.sequential }
+
+func (r *concurrencyRule) Apply(*lint.File, lint.Arguments) []lint.Failure {
+	running := atomic.AddInt32(&r.running, 1)
+	defer atomic.AddInt32(&r.running, -1)
+
+	r.Lock()
+	if running > r.max {
+		r.max = running
+	}
+	r.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+	return nil
+}
+
+func TestLintConcurrency(t *testing.T) {
+	tt := map[string]struct {
+		rule       *concurrencyRule
+		maxWorkers int
+		want       int32
+	}{
+		"bounded workers": {rule: &concurrencyRule{}, maxWorkers: 2, want: 2},
+		"sequential rule": {rule: &concurrencyRule{sequential: true}, maxWorkers: 4, want: 1},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			l := lint.New(func(string) ([]byte, error) { return []byte("package pkg\n"), nil }, 0)
+			packages := [][]string{{"a.go", "b.go", "c.go"}, {"d.go", "e.go", "f.go"}}
+
+			failures, err := l.Lint(packages, []lint.Rule{tc.rule}, lint.Config{MaxWorkers: tc.maxWorkers})
+			if err != nil {
+				t.Fatal(err)
+			}
+			for range failures {
+			}
+
+			if tc.rule.max > tc.want {
+				t.Fatalf("expected at most %d files linted at the same time, got %d", tc.want, tc.rule.max)
+			}
+		})
+	}
+}
+
+// relatedRule reports the package clause, related to the first declaration of the file
+type relatedRule struct{}
+
+func (relatedRule) Name() string { return "related" }
+
+func (relatedRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	return []lint.Failure{{
+		Node:               file.AST.Name,
+		Failure:            "package",
+		Confidence:         1,
+		RelatedInformation: []lint.RelatedInformation{{Node: file.AST.Decls[0], Message: "first declaration"}},
+	}}
+}
+
+func TestRelatedInformationPositions(t *testing.T) {
+	l := lint.New(func(string) ([]byte, error) { return nil, errors.New("the file system must not be read") }, 0)
+
+	failures, err := l.LintReader("a.go", strings.NewReader("package pkg\n\nfunc foo() {}\n"), []lint.Rule{relatedRule{}}, lint.Config{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for f := range failures {
+		if len(f.RelatedInformation) != 1 {
+			t.Fatalf("expected 1 related information, got %v", f.RelatedInformation)
+		}
+		if got := f.RelatedInformation[0].Position.Start; got.Filename != "a.go" || got.Line != 3 || got.Column != 1 {
+			t.Fatalf("expected the related information at a.go:3:1, got %v", got)
+		}
+	}
+}
@@ -22,6 +22,10 @@ type Package struct {
 	sortable map[string]bool
 	// main is whether this is a "main" package.
 	main int
+	// directives are the disabling directives of the package doc comments.
+	directives []packageDirective
+	// astCache holds the syntax trees of the already parsed files, if set.
+	astCache *ASTCache
 	sync.RWMutex
 }
 
@@ -93,10 +97,12 @@ func (p *Package) TypeCheck() error {
 		Importer: importer.Default(),
 	}
 	info := &types.Info{
-		Types:  make(map[ast.Expr]types.TypeAndValue),
-		Defs:   make(map[*ast.Ident]types.Object),
-		Uses:   make(map[*ast.Ident]types.Object),
-		Scopes: make(map[ast.Node]*types.Scope),
+		Types:      make(map[ast.Expr]types.TypeAndValue),
+		Defs:       make(map[*ast.Ident]types.Object),
+		Uses:       make(map[*ast.Ident]types.Object),
+		Scopes:     make(map[ast.Node]*types.Scope),
+		Instances:  make(map[*ast.Ident]types.Instance),
+		Selections: make(map[*ast.SelectorExpr]*types.Selection),
 	}
 	var anyFile *File
 	var astFiles []*ast.File
@@ -176,14 +182,47 @@ func (p *Package) scanSortable() {
 	}
 }
 
-func (p *Package) lint(rules []Rule, config Config, failures chan Failure) {
+// packageDirective is a disabling directive found in the package doc comment of a file.
+// It disables rules in every file of the package.
+type packageDirective struct {
+	file      *File
+	ruleNames []string // empty if the directive disables all the rules
+	reason    string
+}
+
+func (p *Package) scanDirectives() {
+	p.directives = nil
+	for _, f := range p.files {
+		if f.AST.Doc == nil {
+			continue
+		}
+		for _, c := range f.AST.Doc.List {
+			match := re.FindStringSubmatch(c.Text)
+			if len(match) == 0 || match[directivePos] != "disable" || match[modifierPos] != "" {
+				continue
+			}
+			p.directives = append(p.directives, packageDirective{
+				file:      f,
+				ruleNames: directiveRuleNames(match),
+				reason:    match[reasonPos],
+			})
+		}
+	}
+}
+
+// lint lints the files of the package concurrently, at most cap(workerTokens) files at the same time
+func (p *Package) lint(rules []Rule, config Config, failures chan Failure, workerTokens chan struct{}) {
 	p.scanSortable()
+	p.scanDirectives()
 	var wg sync.WaitGroup
 	for _, file := range p.files {
 		wg.Add(1)
 		go (func(file *File) {
-			file.lint(rules, config, failures)
 			defer wg.Done()
+			// "take" a token, it blocks until a worker is available
+			workerTokens <- struct{}{}
+			defer func() { <-workerTokens }()
+			file.lint(rules, config, failures)
 		})(file)
 	}
 	wg.Wait()
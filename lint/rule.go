@@ -17,6 +17,16 @@ type Rule interface {
 	Apply(*File, Arguments) []Failure
 }
 
+// SequentialRule is implemented by rules that are not safe for concurrent use.
+// Files are linted concurrently, thus a rule keeping state across calls to Apply
+// without synchronizing its accesses (e.g. a rule configured in Apply without a mutex)
+// must implement SequentialRule and return true: the linter then never applies
+// sequential rules to several files at the same time.
+type SequentialRule interface {
+	Rule
+	IsSequential() bool
+}
+
 // AbstractRule defines an abstract rule.
 type AbstractRule struct {
 	Failures []Failure
@@ -2,9 +2,12 @@
 package revivelib
 
 import (
+	"fmt"
+	"io"
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/mgechev/dots"
 	"github.com/mgechev/revive/config"
@@ -20,6 +23,9 @@ type Revive struct {
 	lintingRules []lint.Rule
 	logger       *log.Logger
 	maxOpenFiles int
+	// linter and lintStart describe the last linting, for the formatters reporting a summary
+	linter    *lint.Linter
+	lintStart time.Time
 }
 
 // New creates a new instance of Revive lint runner.
@@ -34,6 +40,12 @@ func New(
 		return nil, errors.Wrap(err, "initializing revive - getting logger")
 	}
 
+	switch conf.FailOn {
+	case "", lint.SeverityWarning, lint.SeverityError:
+	default:
+		return nil, errors.Errorf("initializing revive - invalid failOn %q, expecting %q or %q", conf.FailOn, lint.SeverityWarning, lint.SeverityError)
+	}
+
 	if setExitStatus {
 		conf.ErrorCode = 1
 		conf.WarningCode = 1
@@ -44,6 +56,7 @@ func New(
 		extraRuleInstances[i] = extraRule.Rule
 
 		ruleName := extraRule.Rule.Name()
+		conf.RegisteredRules = append(conf.RegisteredRules, ruleName)
 
 		_, isRuleAlreadyConfigured := conf.Rules[ruleName]
 		if !isRuleAlreadyConfigured {
@@ -56,6 +69,10 @@ func New(
 		return nil, errors.Wrap(err, "initializing revive - getting lint rules")
 	}
 
+	if conf.DiscoverConfigs {
+		config.SetDirectoryConfigs(conf, lintingRules, extraRuleInstances)
+	}
+
 	logger.Println("Config loaded")
 
 	return &Revive{
@@ -97,6 +114,7 @@ func (r *Revive) Lint(patterns ...*LintPattern) (<-chan lint.Failure, error) {
 
 		return contents, nil
 	}, r.maxOpenFiles)
+	r.linter, r.lintStart = &revive, time.Now()
 
 	failures, err := revive.Lint(packages, r.lintingRules, *r.config)
 	if err != nil {
@@ -106,10 +124,54 @@ func (r *Revive) Lint(patterns ...*LintPattern) (<-chan lint.Failure, error) {
 	return failures, nil
 }
 
+// LintReader lints the content read from reader as if it were the file named filename, without accessing the file system
+func (r *Revive) LintReader(filename string, reader io.Reader) (<-chan lint.Failure, error) {
+	revive := lint.New(os.ReadFile, r.maxOpenFiles)
+	r.linter, r.lintStart = &revive, time.Now()
+
+	failures, err := revive.LintReader(filename, reader, r.lintingRules, *r.config)
+	if err != nil {
+		return nil, errors.Wrap(err, "linting - reading content")
+	}
+
+	return failures, nil
+}
+
 // Format gets the output for a given failures channel from Lint.
 func (r *Revive) Format(
 	formatterName string,
 	failuresChan <-chan lint.Failure,
+) (string, int, error) {
+	return r.format(formatterName, failuresChan, nil)
+}
+
+// FormatTo writes the output for a given failures channel from Lint to w.
+// Streaming formatters (e.g. ndjson) write each failure as soon as it is received,
+// other formatters write their whole output once the linting is over.
+func (r *Revive) FormatTo(
+	formatterName string,
+	failuresChan <-chan lint.Failure,
+	w io.Writer,
+) (int, error) {
+	output, exitCode, err := r.format(formatterName, failuresChan, w)
+	if err != nil {
+		return exitCode, err
+	}
+
+	if output != "" {
+		if _, err := fmt.Fprintln(w, output); err != nil {
+			return exitCode, errors.Wrap(err, "formatting - writing output")
+		}
+	}
+
+	return exitCode, nil
+}
+
+// format formats the failures; if w is not nil, streaming formatters write to it directly and the returned output is empty
+func (r *Revive) format(
+	formatterName string,
+	failuresChan <-chan lint.Failure,
+	w io.Writer,
 ) (string, int, error) {
 	conf := r.config
 	formatChan := make(chan lint.Failure)
@@ -125,12 +187,24 @@ func (r *Revive) Format(
 		formatErr error
 	)
 
+	summary := lint.Summary{Rules: len(r.lintingRules), Failures: map[lint.Severity]int{}}
 	go func() {
-		output, formatErr = formatter.Format(formatChan, *conf)
+		streamFormatter, isStreamFormatter := formatter.(lint.StreamFormatter)
+		summaryFormatter, isSummaryFormatter := formatter.(lint.SummaryFormatter)
+		switch {
+		case w != nil && isStreamFormatter:
+			formatErr = streamFormatter.FormatStream(formatChan, *conf, w)
+		case isSummaryFormatter:
+			output, formatErr = summaryFormatter.FormatWithSummary(formatChan, *conf, func() lint.Summary { return summary })
+		default:
+			output, formatErr = formatter.Format(formatChan, *conf)
+		}
 
+		for range formatChan {
+			// drain the failures left by a formatter that failed, to not block the linting
+		}
 		exitChan <- true
 	}()
-
 	exitCode := 0
 
 	for failure := range failuresChan {
@@ -138,26 +212,31 @@ func (r *Revive) Format(
 			continue
 		}
 
-		if exitCode == 0 {
-			exitCode = conf.WarningCode
-		}
-
-		if c, ok := conf.Rules[failure.RuleName]; ok && c.Severity == lint.SeverityError {
-			exitCode = conf.ErrorCode
-		}
-
-		if c, ok := conf.Directives[failure.RuleName]; ok && c.Severity == lint.SeverityError {
+		failureSeverity := conf.FailureSeverity(failure)
+		summary.Failures[failureSeverity]++
+		switch failureSeverity {
+		case lint.SeverityError:
 			exitCode = conf.ErrorCode
+		case lint.SeverityWarning:
+			if exitCode == 0 && conf.FailOn != lint.SeverityError {
+				exitCode = conf.WarningCode
+			}
 		}
 
 		formatChan <- failure
 	}
 
+	// the linting is over once all the failures are received
+	if r.linter != nil {
+		summary.Files = r.linter.FilesLinted()
+		summary.Duration = time.Since(r.lintStart)
+	}
+
 	close(formatChan)
 	<-exitChan
 
 	if formatErr != nil {
-		return "", exitCode, errors.Wrap(err, "formatting")
+		return "", exitCode, errors.Wrap(formatErr, "formatting")
 	}
 
 	return output, exitCode, nil
@@ -1,6 +1,8 @@
 package revivelib_test
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -72,6 +74,161 @@ func TestReviveFormat(t *testing.T) {
 	}
 }
 
+func TestReviveFormatSummary(t *testing.T) {
+	revive := getMockRevive(t)
+
+	failuresChan, err := revive.Lint(revivelib.Include("../testdata/if-return.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	output, _, err := revive.Format("json-summary", failuresChan)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"Summary":{"Files":1,"Rules":`
+	if !strings.HasPrefix(output, want) {
+		t.Fatalf("Expected the output\n'%s'\nto start with\n'%s', but it didn't.", output, want)
+	}
+	if !strings.Contains(output, `"Failures":{"warning":5}`) {
+		t.Fatalf("Expected the output\n'%s'\nto count 5 warnings, but it didn't.", output)
+	}
+}
+
+func TestReviveFormatTo(t *testing.T) {
+	revive := getMockRevive(t)
+
+	for formatterName, wantLines := range map[string]int{"ndjson": 5, "json": 1} {
+		failuresChan, err := revive.Lint(revivelib.Include("../testdata/if-return.go"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var output strings.Builder
+		exitCode, err := revive.FormatTo(formatterName, failuresChan, &output)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if exitCode != 1 {
+			t.Fatalf("Expected exit code to be 1, but it was %d.", exitCode)
+		}
+		lines := strings.Split(strings.TrimSuffix(output.String(), "\n"), "\n")
+		if len(lines) != wantLines {
+			t.Fatalf("Expected the %s output\n'%s'\nto have %d lines, but it has %d.", formatterName, output.String(), wantLines, len(lines))
+		}
+	}
+}
+
+func TestReviveFormatFailOn(t *testing.T) {
+	tt := map[string]struct {
+		ruleSeverity lint.Severity
+		wantExitCode int
+	}{
+		"warnings only":      {ruleSeverity: lint.SeverityWarning, wantExitCode: 0},
+		"error and warnings": {ruleSeverity: lint.SeverityError, wantExitCode: 1},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			conf, err := config.GetConfig("../defaults.toml")
+			if err != nil {
+				t.Fatal(err)
+			}
+			conf.FailOn = lint.SeverityError
+			revive, err := revivelib.New(
+				conf,
+				true,
+				2048,
+				revivelib.NewExtraRule(&rule.IfReturnRule{}, lint.RuleConfig{Severity: tc.ruleSeverity}),
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			failuresChan, err := revive.Lint(revivelib.Include("../testdata/if-return.go"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, exitCode, err := revive.Format("plain", failuresChan)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if exitCode != tc.wantExitCode {
+				t.Fatalf("Expected exit code to be %d, but it was %d.", tc.wantExitCode, exitCode)
+			}
+		})
+	}
+}
+
+func TestReviveFormatDirectoryConfigs(t *testing.T) {
+	tt := map[string]struct {
+		nestedConfig string
+		wantExitCode int
+	}{
+		"root severity":   {wantExitCode: 0},
+		"nested severity": {nestedConfig: "[rule.argument-limit]\n  arguments = [1]\n  severity = \"error\"\n", wantExitCode: 1},
+	}
+
+	for name, tc := range tt {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			writeFile(t, filepath.Join(dir, "base.toml"), "discoverConfigs = true\nfailOn = \"error\"\n\n[rule.argument-limit]\n  arguments = [1]\n")
+			writeFile(t, filepath.Join(dir, "nested", "file.go"), "package nested\n\nfunc f(a, b int) {}\n")
+			writeFile(t, filepath.Join(dir, "nested", ".revive.toml"), "root = true\n"+tc.nestedConfig)
+
+			conf, err := config.GetConfig(filepath.Join(dir, "base.toml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			revive, err := revivelib.New(conf, true, 2048)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			failuresChan, err := revive.Lint(revivelib.Include(filepath.Join(dir, "nested", "file.go")))
+			if err != nil {
+				t.Fatal(err)
+			}
+			output, exitCode, err := revive.Format("plain", failuresChan)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !strings.Contains(output, "argument-limit") {
+				t.Fatalf("Expected the output\n'%s'\nto report argument-limit, but it didn't.", output)
+			}
+			if exitCode != tc.wantExitCode {
+				t.Fatalf("Expected exit code to be %d, but it was %d.", tc.wantExitCode, exitCode)
+			}
+		})
+	}
+}
+
+func writeFile(t *testing.T, path, content string) {
+	t.Helper()
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReviveInvalidFailOn(t *testing.T) {
+	conf, err := config.GetConfig("../defaults.toml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf.FailOn = "info"
+
+	if _, err := revivelib.New(conf, true, 2048); err == nil {
+		t.Fatal("Expected an error for an invalid failOn")
+	}
+}
+
 type mockRule struct{}
 
 func (r *mockRule) Name() string {
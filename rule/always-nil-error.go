@@ -0,0 +1,175 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// AlwaysNilErrorRule spots functions declaring an error result that is always nil.
+type AlwaysNilErrorRule struct {
+	configured           bool
+	skipInterfaceMethods bool
+	sync.Mutex
+}
+
+func (r *AlwaysNilErrorRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+	}
+
+	for k, v := range args {
+		switch k {
+		case "skipInterfaceMethods":
+			r.skipInterfaceMethods, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a boolean, got %T", v, k, r.Name(), v))
+			}
+		default:
+			panic(fmt.Sprintf("Unknown argument '%s' for rule '%s'", k, r.Name()))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *AlwaysNilErrorRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	w := lintAlwaysNilError{file: file, onFailure: onFailure}
+	if r.skipInterfaceMethods {
+		file.Pkg.TypeCheck()
+		w.interfaces = knownInterfaces(file.Pkg.TypesPkg())
+	}
+
+	for _, decl := range file.AST.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			w.check(fd)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*AlwaysNilErrorRule) Name() string {
+	return "always-nil-error"
+}
+
+type lintAlwaysNilError struct {
+	file       *lint.File
+	interfaces []*types.Interface
+	onFailure  func(lint.Failure)
+}
+
+func (w lintAlwaysNilError) check(fd *ast.FuncDecl) {
+	results := fd.Type.Results
+	if fd.Body == nil || results == nil {
+		return
+	}
+
+	last := results.List[len(results.List)-1]
+	if !isIdent(last.Type, "error") || len(last.Names) > 0 {
+		return // not an error or a named result that deferred calls could modify
+	}
+
+	returns := 0
+	alwaysNil := true
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if !alwaysNil {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns++
+			alwaysNil = len(n.Results) > 0 && isIdent(n.Results[len(n.Results)-1], "nil")
+		}
+		return true
+	})
+
+	if returns == 0 || !alwaysNil || matchesInterfaceMethod(w.file.Pkg.TypesInfo(), w.interfaces, fd) {
+		return
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "errors",
+		Confidence: 0.8,
+		Node:       fd.Type,
+		Failure:    fmt.Sprintf("%s always returns a nil error, consider removing the error result", fd.Name.Name),
+	})
+}
+
+// matchesInterfaceMethod returns true if the given function is a method
+// with the name and the signature of a method of one of the given interfaces.
+func matchesInterfaceMethod(info *types.Info, interfaces []*types.Interface, fd *ast.FuncDecl) bool {
+	if fd.Recv == nil || len(interfaces) == 0 {
+		return false
+	}
+
+	fn, ok := info.Defs[fd.Name].(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	for _, iface := range interfaces {
+		for i := 0; i < iface.NumMethods(); i++ {
+			m := iface.Method(i)
+			if m.Name() != fn.Name() {
+				continue
+			}
+			msig := m.Type().(*types.Signature)
+			if types.Identical(types.NewSignatureType(nil, nil, nil, sig.Params(), sig.Results(), sig.Variadic()),
+				types.NewSignatureType(nil, nil, nil, msig.Params(), msig.Results(), msig.Variadic())) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// knownInterfaces returns the interfaces declared in the given package and in the packages it imports.
+func knownInterfaces(pkg *types.Package) []*types.Interface {
+	if pkg == nil {
+		return nil
+	}
+
+	var result []*types.Interface
+	for _, p := range append([]*types.Package{pkg}, pkg.Imports()...) {
+		scope := p.Scope()
+		for _, name := range scope.Names() {
+			tn, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok {
+				continue
+			}
+			if iface, ok := tn.Type().Underlying().(*types.Interface); ok {
+				result = append(result, iface)
+			}
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,122 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// AlwaysTrueOkRule spots functions declaring a final bool result that is always true.
+type AlwaysTrueOkRule struct {
+	configured           bool
+	skipInterfaceMethods bool
+	sync.Mutex
+}
+
+func (r *AlwaysTrueOkRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+	}
+
+	for k, v := range args {
+		switch k {
+		case "skipInterfaceMethods":
+			r.skipInterfaceMethods, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a boolean, got %T", v, k, r.Name(), v))
+			}
+		default:
+			panic(fmt.Sprintf("Unknown argument '%s' for rule '%s'", k, r.Name()))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *AlwaysTrueOkRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	w := lintAlwaysTrueOk{file: file, onFailure: onFailure}
+	if r.skipInterfaceMethods {
+		file.Pkg.TypeCheck()
+		w.interfaces = knownInterfaces(file.Pkg.TypesPkg())
+	}
+
+	for _, decl := range file.AST.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok {
+			w.check(fd)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*AlwaysTrueOkRule) Name() string {
+	return "always-true-ok"
+}
+
+type lintAlwaysTrueOk struct {
+	file       *lint.File
+	interfaces []*types.Interface
+	onFailure  func(lint.Failure)
+}
+
+func (w lintAlwaysTrueOk) check(fd *ast.FuncDecl) {
+	results := fd.Type.Results
+	if fd.Body == nil || results == nil || len(results.List) < 2 {
+		return // not a comma-ok function
+	}
+
+	last := results.List[len(results.List)-1]
+	if !isIdent(last.Type, "bool") || len(last.Names) > 0 {
+		return // not a bool or a named result that deferred calls could modify
+	}
+
+	returns := 0
+	alwaysTrue := true
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		if !alwaysTrue {
+			return false
+		}
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns++
+			alwaysTrue = len(n.Results) > 0 && isIdent(n.Results[len(n.Results)-1], "true")
+		}
+		return true
+	})
+
+	if returns == 0 || !alwaysTrue || matchesInterfaceMethod(w.file.Pkg.TypesInfo(), w.interfaces, fd) {
+		return
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 0.8,
+		Node:       fd.Type,
+		Failure:    fmt.Sprintf("%s always returns true as its last result, consider removing the bool result", fd.Name.Name),
+	})
+}
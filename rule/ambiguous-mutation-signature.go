@@ -0,0 +1,151 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// AmbiguousMutationSignatureRule spots functions that mutate a reference-type parameter and also return a value of the same type.
+type AmbiguousMutationSignatureRule struct{}
+
+// Apply applies the rule to given file.
+func (*AmbiguousMutationSignatureRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintAmbiguousMutationSignature{file, onFailure}
+	for _, decl := range file.AST.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			w.checkFunc(fn)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*AmbiguousMutationSignatureRule) Name() string {
+	return "ambiguous-mutation-signature"
+}
+
+type lintAmbiguousMutationSignature struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintAmbiguousMutationSignature) checkFunc(fn *ast.FuncDecl) {
+	if fn.Type.Results == nil {
+		return
+	}
+
+	var results []types.Type
+	for _, field := range fn.Type.Results.List {
+		if t := w.file.Pkg.TypeOf(field.Type); t != nil {
+			results = append(results, t)
+		}
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	candidates := map[types.Object]bool{}
+	for _, field := range fn.Type.Params.List {
+		t := w.file.Pkg.TypeOf(field.Type)
+		if t == nil || !isReferenceType(t) || !containsIdentical(results, t) {
+			continue
+		}
+		for _, name := range field.Names {
+			if obj := info.Defs[name]; obj != nil {
+				candidates[obj] = true
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		return
+	}
+
+	// isParam returns the name of the candidate parameter the expression refers to, if any
+	isParam := func(expr ast.Expr) (string, bool) {
+		id, ok := expr.(*ast.Ident)
+		if !ok || !candidates[info.Uses[id]] {
+			return "", false
+		}
+		return id.Name, true
+	}
+
+	// mutated returns the name of the parameter mutated through the given expression, if any
+	mutated := func(expr ast.Expr) (string, bool) {
+		switch e := expr.(type) {
+		case *ast.IndexExpr:
+			return isParam(e.X)
+		case *ast.SelectorExpr:
+			return isParam(e.X)
+		case *ast.StarExpr:
+			return isParam(e.X)
+		}
+		return "", false
+	}
+
+	var name string
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		if name != "" {
+			return false
+		}
+
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if param, ok := mutated(lhs); ok {
+					name = param
+				}
+			}
+		case *ast.IncDecStmt:
+			if param, ok := mutated(n.X); ok {
+				name = param
+			}
+		case *ast.CallExpr:
+			if (isIdent(n.Fun, "delete") || isIdent(n.Fun, "clear")) && len(n.Args) > 0 {
+				if param, ok := isParam(n.Args[0]); ok {
+					name = param
+				}
+			}
+		}
+		return true
+	})
+
+	if name == "" {
+		return
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "bad practice",
+		Confidence: 0.6,
+		Node:       fn.Name,
+		Failure:    fmt.Sprintf("function %s mutates its parameter %s and returns a value of the same type, callers can not tell if the result is %s or a copy; either mutate in place without returning it or return a modified copy", fn.Name.Name, name, name),
+	})
+}
+
+// isReferenceType returns true if the given type is a map, a slice or a pointer
+func isReferenceType(t types.Type) bool {
+	switch t.Underlying().(type) {
+	case *types.Map, *types.Slice, *types.Pointer:
+		return true
+	}
+	return false
+}
+
+func containsIdentical(list []types.Type, t types.Type) bool {
+	for _, candidate := range list {
+		if types.Identical(candidate, t) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// AssignmentInConditionRule spots assignments (=) in the initializer of if and switch statements
+// that were probably meant to be short variable declarations (:=).
+type AssignmentInConditionRule struct{}
+
+// Apply applies the rule to given file.
+func (*AssignmentInConditionRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			var init ast.Stmt
+			var kind string
+			switch stmt := n.(type) {
+			case *ast.IfStmt:
+				init, kind = stmt.Init, "if"
+			case *ast.SwitchStmt:
+				init, kind = stmt.Init, "switch"
+			case *ast.TypeSwitchStmt:
+				init, kind = stmt.Init, "switch"
+			default:
+				return true
+			}
+
+			assign, ok := init.(*ast.AssignStmt)
+			if !ok || assign.Tok != token.ASSIGN {
+				return true
+			}
+
+			names := []string{}
+			for _, lhs := range assign.Lhs {
+				id, ok := lhs.(*ast.Ident)
+				if !ok {
+					return true // assigns a field, an element...
+				}
+				if isBlank(id) {
+					continue
+				}
+
+				v, ok := info.Uses[id].(*types.Var)
+				if !ok || v.Pos() < fn.Body.Pos() || isUsedOutside(info, fn.Body, v, n) {
+					return true // not a local variable or its value is needed elsewhere
+				}
+				names = append(names, id.Name)
+			}
+
+			if len(names) == 0 {
+				return true
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "logic",
+				Confidence: 0.6,
+				Node:       assign,
+				Failure: fmt.Sprintf("the %s statement initializer assigns %s declared outside of it but not used elsewhere, use := to declare variables scoped to the %s statement",
+					kind, strings.Join(names, ", "), kind),
+			})
+
+			return true
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*AssignmentInConditionRule) Name() string {
+	return "assignment-in-condition"
+}
+
+// isUsedOutside returns true if the variable is referenced, within the given node, outside of the given statement
+func isUsedOutside(info *types.Info, node ast.Node, v *types.Var, stmt ast.Node) bool {
+	used := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if used || n == stmt {
+			return false
+		}
+		if id, ok := n.(*ast.Ident); ok && info.Uses[id] == v {
+			used = true
+		}
+		return true
+	})
+
+	return used
+}
@@ -0,0 +1,154 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+var defaultSetterPatterns = []string{"^Set[A-Z]", "^With[A-Z]"}
+
+// BuilderSetterReturnsRule spots setters of builder types that, unlike the other setters of the type, do not return the receiver.
+type BuilderSetterReturnsRule struct {
+	setterPatterns []*regexp.Regexp
+	sync.Mutex
+}
+
+func (r *BuilderSetterReturnsRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.setterPatterns != nil {
+		return
+	}
+
+	patterns := defaultSetterPatterns
+	if len(arguments) > 0 {
+		patterns = make([]string, 0, len(arguments))
+		for _, arg := range arguments {
+			pattern, ok := arg.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	r.setterPatterns = make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a valid regular expression, got %q: %v", r.Name(), pattern, err))
+		}
+		r.setterPatterns = append(r.setterPatterns, re)
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *BuilderSetterReturnsRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	file.Pkg.TypeCheck()
+
+	// setters of all the types of the package, methods of a type can be spread over several files
+	type typeSetters struct {
+		chaining    int
+		notChaining []*ast.FuncDecl
+	}
+	settersOf := map[*types.TypeName]*typeSetters{}
+	for _, f := range file.Pkg.Files() {
+		for _, decl := range f.AST.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || !r.isSetter(fn.Name.Name) {
+				continue
+			}
+
+			recv, returnsReceiver := setterReceiver(file.Pkg.TypesInfo(), fn)
+			if recv == nil {
+				continue
+			}
+
+			setters, ok := settersOf[recv]
+			if !ok {
+				setters = &typeSetters{}
+				settersOf[recv] = setters
+			}
+
+			if returnsReceiver {
+				setters.chaining++
+			} else if f == file {
+				setters.notChaining = append(setters.notChaining, fn)
+			}
+		}
+	}
+
+	var failures []lint.Failure
+	for recv, setters := range settersOf {
+		// the type is considered a builder if most of its setters return the receiver
+		if setters.chaining < 2 || setters.chaining <= len(setters.notChaining) {
+			continue
+		}
+
+		for _, fn := range setters.notChaining {
+			failures = append(failures, lint.Failure{
+				Category:   "style",
+				Confidence: 0.8,
+				Node:       fn.Name,
+				Failure:    fmt.Sprintf("setter %s does not return the receiver, unlike other setters of %s, thus it breaks method chaining", fn.Name.Name, recv.Name()),
+			})
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*BuilderSetterReturnsRule) Name() string {
+	return "builder-setter-returns"
+}
+
+func (r *BuilderSetterReturnsRule) isSetter(name string) bool {
+	for _, re := range r.setterPatterns {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setterReceiver returns the named type of the receiver of the given method and
+// whether the method returns a single value of that type (or a pointer to it)
+func setterReceiver(info *types.Info, fn *ast.FuncDecl) (*types.TypeName, bool) {
+	method, ok := info.Defs[fn.Name].(*types.Func)
+	if !ok {
+		return nil, false
+	}
+
+	sig := method.Type().(*types.Signature)
+	recv := namedOf(sig.Recv().Type())
+	if recv == nil {
+		return nil, false
+	}
+
+	if sig.Results().Len() != 1 {
+		return recv.Obj(), false
+	}
+
+	result := namedOf(sig.Results().At(0).Type())
+	return recv.Obj(), result != nil && result.Obj() == recv.Obj()
+}
+
+// namedOf returns the named type T of a type T or *T, or nil
+func namedOf(t types.Type) *types.Named {
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+
+	named, _ := t.(*types.Named)
+	return named
+}
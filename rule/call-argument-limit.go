@@ -0,0 +1,103 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// CallArgumentLimitRule spots calls passing too many positional arguments.
+type CallArgumentLimitRule struct {
+	max int
+	sync.Mutex
+}
+
+const defaultCallArgumentLimit = 8
+
+func (r *CallArgumentLimitRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.max != 0 {
+		return
+	}
+
+	r.max = defaultCallArgumentLimit
+	if len(arguments) < 1 {
+		return
+	}
+
+	max, ok := arguments[0].(int64)
+	if !ok || max < 1 {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a positive integer, got %v", r.Name(), arguments[0]))
+	}
+	r.max = int(max)
+}
+
+// Apply applies the rule to given file.
+func (r *CallArgumentLimitRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		num := positionalArguments(info, call)
+		if num <= r.max {
+			return true
+		}
+
+		callee := gofmt(call.Fun)
+		if _, ok := call.Fun.(*ast.FuncLit); ok {
+			callee = "function literal"
+		}
+		failures = append(failures, lint.Failure{
+			Category:   "code-style",
+			Confidence: 1,
+			Node:       call,
+			Failure:    fmt.Sprintf("call to %s passes %d positional arguments, more than the maximum of %d; consider a wrapper function or an options struct", callee, num, r.max),
+		})
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*CallArgumentLimitRule) Name() string {
+	return "call-argument-limit"
+}
+
+// positionalArguments returns the number of arguments of the call, not counting those of variadic parameters
+func positionalArguments(info *types.Info, call *ast.CallExpr) int {
+	if info == nil {
+		return len(call.Args)
+	}
+
+	if id, ok := unparen(call.Fun).(*ast.Ident); ok {
+		if _, isBuiltin := info.Uses[id].(*types.Builtin); isBuiltin {
+			return 0 // e.g. append
+		}
+	}
+
+	sig, ok := info.TypeOf(call.Fun).(*types.Signature)
+	if !ok || !sig.Variadic() || call.Ellipsis.IsValid() {
+		return len(call.Args)
+	}
+
+	fixed := sig.Params().Len() - 1
+	if len(call.Args) < fixed {
+		return len(call.Args)
+	}
+	return fixed
+}
@@ -0,0 +1,163 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const defaultMinCombinableAssignments = 2
+
+// CombineAssignmentsRule spots runs of consecutive independent assignments that could be a single multi-assignment.
+type CombineAssignmentsRule struct {
+	min int
+	sync.Mutex
+}
+
+func (r *CombineAssignmentsRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.min != 0 {
+		return
+	}
+
+	if len(arguments) < 1 {
+		r.min = defaultMinCombinableAssignments
+		return
+	}
+
+	min, ok := arguments[0].(int64)
+	if !ok || min < 2 {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting an integer greater than 1", arguments[0], r.Name()))
+	}
+	r.min = int(min)
+}
+
+// Apply applies the rule to given file.
+func (r *CombineAssignmentsRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	checkStmts := func(stmts []ast.Stmt) {
+		var run []*ast.AssignStmt
+		flush := func() {
+			if len(run) >= r.min {
+				failures = append(failures, lint.Failure{
+					Category:   "style",
+					Confidence: 0.8,
+					Node:       run[0],
+					Failure:    fmt.Sprintf("%d consecutive independent assignments could be combined into a single one: %s", len(run), combinedAssignment(run)),
+				})
+			}
+			run = nil
+		}
+
+		for _, stmt := range stmts {
+			assign, ok := stmt.(*ast.AssignStmt)
+			if !ok || !isCombinableAssignment(assign) {
+				flush()
+				continue
+			}
+
+			if len(run) > 0 && !isIndependentAssignment(assign, run) {
+				flush()
+			}
+			run = append(run, assign)
+		}
+		flush()
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			checkStmts(n.List)
+		case *ast.CaseClause:
+			checkStmts(n.Body)
+		case *ast.CommClause:
+			checkStmts(n.Body)
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*CombineAssignmentsRule) Name() string {
+	return "combine-assignments"
+}
+
+// isCombinableAssignment returns true if the given statement assigns, or declares, a single variable
+// with an expression without side effects
+func isCombinableAssignment(assign *ast.AssignStmt) bool {
+	if (assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE) || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+		return false
+	}
+
+	if _, ok := assign.Lhs[0].(*ast.Ident); !ok {
+		return false
+	}
+
+	return !mayHaveSideEffects(assign.Rhs[0])
+}
+
+// mayHaveSideEffects returns true if the evaluation of the given expression involves function calls or channel receives
+func mayHaveSideEffects(expr ast.Expr) bool {
+	sideEffects := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr, *ast.FuncLit:
+			sideEffects = true
+		case *ast.UnaryExpr:
+			sideEffects = sideEffects || n.Op == token.ARROW
+		}
+		return !sideEffects
+	})
+
+	return sideEffects
+}
+
+// isIndependentAssignment returns true if the given assignment can be combined with the previous ones:
+// it uses the same operator, assigns a different variable, and does not refer to previously assigned ones
+func isIndependentAssignment(assign *ast.AssignStmt, previous []*ast.AssignStmt) bool {
+	if assign.Tok != previous[0].Tok {
+		return false
+	}
+
+	assigned := map[string]bool{}
+	for _, p := range previous {
+		assigned[p.Lhs[0].(*ast.Ident).Name] = true
+	}
+
+	if assigned[assign.Lhs[0].(*ast.Ident).Name] {
+		return false
+	}
+
+	independent := true
+	ast.Inspect(assign.Rhs[0], func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && assigned[id.Name] {
+			independent = false
+		}
+		return independent
+	})
+
+	return independent
+}
+
+// combinedAssignment renders the single multi-assignment equivalent to the given assignments
+func combinedAssignment(assigns []*ast.AssignStmt) string {
+	lhs := make([]string, len(assigns))
+	rhs := make([]string, len(assigns))
+	for i, assign := range assigns {
+		lhs[i] = gofmt(assign.Lhs[0])
+		rhs[i] = gofmt(assign.Rhs[0])
+	}
+
+	return fmt.Sprintf("%s %s %s", strings.Join(lhs, ", "), assigns[0].Tok, strings.Join(rhs, ", "))
+}
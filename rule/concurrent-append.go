@@ -0,0 +1,112 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ConcurrentAppendRule spots appends, within goroutines, to slices declared outside of them.
+type ConcurrentAppendRule struct{}
+
+// Apply applies the rule to given file.
+func (*ConcurrentAppendRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		goStmt, ok := n.(*ast.GoStmt)
+		if !ok {
+			return true
+		}
+		lit, ok := goStmt.Call.Fun.(*ast.FuncLit)
+		if !ok || isLockingBody(file.Pkg, lit.Body) {
+			return true
+		}
+
+		ast.Inspect(lit.Body, func(n ast.Node) bool {
+			assign, ok := n.(*ast.AssignStmt)
+			if !ok || assign.Tok != token.ASSIGN || len(assign.Lhs) != len(assign.Rhs) {
+				return true
+			}
+
+			for i, rhs := range assign.Rhs {
+				call, ok := rhs.(*ast.CallExpr)
+				if !ok || !isIdent(call.Fun, "append") || len(call.Args) == 0 {
+					continue
+				}
+
+				root := rootIdent(assign.Lhs[i])
+				if root == nil {
+					continue
+				}
+				obj := info.Uses[root]
+				if obj == nil || (obj.Pos() >= lit.Pos() && obj.Pos() < lit.End()) {
+					continue // declared within the goroutine
+				}
+
+				failures = append(failures, lint.Failure{
+					Category:   "logic",
+					Confidence: 0.5,
+					Node:       call,
+					Failure:    fmt.Sprintf("appending to %s, declared outside of the goroutine, is a data race if other goroutines access it; protect it with a mutex or send the values over a channel", gofmt(assign.Lhs[i])),
+				})
+			}
+
+			return true
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ConcurrentAppendRule) Name() string {
+	return "concurrent-append"
+}
+
+// isLockingBody returns true if the given block locks a mutex of the sync package
+func isLockingBody(pkg *lint.Package, body *ast.BlockStmt) bool {
+	locking := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if locking {
+			return false
+		}
+		if call, ok := n.(*ast.CallExpr); ok {
+			_, method, ok := syncMethodCall(pkg, call)
+			locking = ok && (method == "Lock" || method == "RLock")
+		}
+		return true
+	})
+
+	return locking
+}
+
+// rootIdent returns the identifier at the root of selector and index expressions (e.g. s for s.items[0])
+func rootIdent(expr ast.Expr) *ast.Ident {
+	for {
+		switch e := expr.(type) {
+		case *ast.Ident:
+			return e
+		case *ast.SelectorExpr:
+			expr = e.X
+		case *ast.IndexExpr:
+			expr = e.X
+		case *ast.StarExpr:
+			expr = e.X
+		case *ast.ParenExpr:
+			expr = e.X
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,142 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const (
+	errorWrappingStyleWrap   = "%w"
+	errorWrappingStyleFormat = "%v"
+)
+
+// ConsistentErrorWrappingRule enforces a consistent style (%w or %v) for errors formatted with fmt.Errorf within a package.
+type ConsistentErrorWrappingRule struct {
+	configured bool
+	preferred  string
+	sync.Mutex
+}
+
+func (r *ConsistentErrorWrappingRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	checkNumberOfArguments(1, arguments, r.Name())
+	preferred, ok := arguments[0].(string)
+	if !ok || (preferred != errorWrappingStyleWrap && preferred != errorWrappingStyleFormat) {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q or %q", arguments[0], r.Name(), errorWrappingStyleWrap, errorWrappingStyleFormat))
+	}
+	r.preferred = preferred
+}
+
+// Apply applies the rule to given file.
+func (r *ConsistentErrorWrappingRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	file.Pkg.TypeCheck()
+
+	preferred := r.preferred
+	if preferred == "" {
+		counts := map[string]int{}
+		for _, f := range file.Pkg.Files() {
+			for _, style := range errorWrappingStyles(f) {
+				counts[style.style]++
+			}
+		}
+
+		switch {
+		case counts[errorWrappingStyleWrap] > counts[errorWrappingStyleFormat]:
+			preferred = errorWrappingStyleWrap
+		case counts[errorWrappingStyleWrap] < counts[errorWrappingStyleFormat]:
+			preferred = errorWrappingStyleFormat
+		default:
+			return nil // no majority
+		}
+	}
+
+	var failures []lint.Failure
+	for _, style := range errorWrappingStyles(file) {
+		if style.style == preferred {
+			continue
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "errors",
+			Confidence: 0.8,
+			Node:       style.call,
+			Failure:    fmt.Sprintf("inconsistent error wrapping, errors are formatted with %s instead of %s", style.style, preferred),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ConsistentErrorWrappingRule) Name() string {
+	return "consistent-error-wrapping"
+}
+
+type errorWrappingStyle struct {
+	call  *ast.CallExpr
+	style string
+}
+
+// errorWrappingStyles returns the calls to fmt.Errorf of the file that format an error, along with the style they use
+func errorWrappingStyles(file *lint.File) []errorWrappingStyle {
+	info := file.Pkg.TypesInfo()
+	var result []errorWrappingStyle
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Errorf" {
+			return true
+		}
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return true
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+
+		if hasWrapVerb(format) {
+			result = append(result, errorWrappingStyle{call, errorWrappingStyleWrap})
+			return true
+		}
+
+		for _, arg := range call.Args[1:] {
+			if t := file.Pkg.TypeOf(arg); t != nil && implementsError(t) {
+				result = append(result, errorWrappingStyle{call, errorWrappingStyleFormat})
+				break
+			}
+		}
+		return true
+	})
+
+	return result
+}
@@ -9,7 +9,7 @@ import (
 	"github.com/mgechev/revive/lint"
 )
 
-// ContextAsArgumentRule lints given else constructs.
+// ContextAsArgumentRule spots functions whose context.Context parameter is not the first one.
 type ContextAsArgumentRule struct {
 	allowTypesLUT map[string]struct{}
 	sync.Mutex
@@ -26,6 +26,7 @@ func (r *ContextAsArgumentRule) Apply(file *lint.File, args lint.Arguments) []li
 	var failures []lint.Failure
 	r.Lock()
 	walker := lintContextArguments{
+		file:          file,
 		allowTypesLUT: r.allowTypesLUT,
 		onFailure: func(failure lint.Failure) {
 			failures = append(failures, failure)
@@ -44,39 +45,48 @@ func (*ContextAsArgumentRule) Name() string {
 }
 
 type lintContextArguments struct {
+	file          *lint.File
 	allowTypesLUT map[string]struct{}
 	onFailure     func(lint.Failure)
 }
 
 func (w lintContextArguments) Visit(n ast.Node) ast.Visitor {
-	fn, ok := n.(*ast.FuncDecl)
-	if !ok || len(fn.Type.Params.List) <= 1 {
+	var fnType *ast.FuncType
+	switch fn := n.(type) {
+	case *ast.FuncDecl:
+		fnType = fn.Type
+	case *ast.FuncLit:
+		fnType = fn.Type
+	default:
 		return w
 	}
 
-	fnArgs := fn.Type.Params.List
+	fnArgs := fnType.Params.List
+	if len(fnArgs) <= 1 {
+		return w
+	}
 
 	// A context.Context should be the first parameter of a function.
 	// Flag any that show up after the first.
 	isCtxStillAllowed := true
 	for _, arg := range fnArgs {
-		argIsCtx := isPkgDot(arg.Type, "context", "Context")
+		typeName := w.file.Render(arg.Type)
+		argIsCtx := typeName == "context.Context"
 		if argIsCtx && !isCtxStillAllowed {
 			w.onFailure(lint.Failure{
 				Node:       arg,
 				Category:   "arg-order",
 				Failure:    "context.Context should be the first parameter of a function",
-				Confidence: 0.9,
+				Confidence: 1,
 			})
 			break // only flag one
 		}
 
-		typeName := gofmt(arg.Type)
 		// a parameter of type context.Context is still allowed if the current arg type is in the LUT
 		_, isCtxStillAllowed = w.allowTypesLUT[typeName]
 	}
 
-	return nil // avoid visiting the function body
+	return w // function literals within the body must be checked too
 }
 
 func getAllowTypesFromArguments(args lint.Arguments) map[string]struct{} {
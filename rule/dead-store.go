@@ -0,0 +1,289 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// DeadStoreRule spots assignments whose value is overwritten before being read.
+type DeadStoreRule struct{}
+
+// Apply applies the rule to given file.
+func (*DeadStoreRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	w := lintDeadStore{
+		info: info,
+		onFailure: func(failure lint.Failure) {
+			failures = append(failures, failure)
+		},
+	}
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			w.checkFunc(n.Body)
+		case *ast.FuncLit:
+			w.checkFunc(n.Body)
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*DeadStoreRule) Name() string {
+	return "dead-store"
+}
+
+type lintDeadStore struct {
+	info      *types.Info
+	onFailure func(lint.Failure)
+}
+
+// store is an assignment of a value to a variable
+type store struct {
+	node ast.Node
+	name string
+}
+
+// checkFunc spots dead stores to the local variables of the function body.
+// The analysis is intentionally conservative: a store is dead only if it is overwritten
+// by a later statement of the same statement list, with no possible read in between.
+func (w lintDeadStore) checkFunc(body *ast.BlockStmt) {
+	if body == nil {
+		return
+	}
+
+	excluded := w.escapingVars(body)
+	isCandidate := func(obj types.Object) bool {
+		v, ok := obj.(*types.Var)
+		return ok && !v.IsField() && !excluded[obj] && body.Pos() <= obj.Pos() && obj.Pos() < body.End()
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // analyzed on its own
+		case *ast.BlockStmt:
+			w.checkStmts(n.List, isCandidate)
+		case *ast.CaseClause:
+			w.checkStmts(n.Body, isCandidate)
+		case *ast.CommClause:
+			w.checkStmts(n.Body, isCandidate)
+		}
+		return true
+	})
+}
+
+func (w lintDeadStore) checkStmts(stmts []ast.Stmt, isCandidate func(types.Object) bool) {
+	pending := map[types.Object]store{}
+	for _, stmt := range stmts {
+		if hasJump(stmt) {
+			// control can reach statements other than the next one, thus the pending stores can be read
+			pending = map[types.Object]store{}
+			continue
+		}
+
+		written := w.storedVars(stmt)
+		for obj := range w.readVars(stmt, written) {
+			delete(pending, obj)
+		}
+
+		for _, id := range written {
+			obj := w.info.ObjectOf(id)
+			if !isCandidate(obj) {
+				continue
+			}
+
+			if previous, ok := pending[obj]; ok {
+				w.onFailure(lint.Failure{
+					Category:   "logic",
+					Confidence: 0.8,
+					Node:       previous.node,
+					Failure:    fmt.Sprintf("the value assigned to %s is never read, it is overwritten before being used", previous.name),
+					RelatedInformation: []lint.RelatedInformation{{
+						Node:    stmt,
+						Message: fmt.Sprintf("%s is overwritten here", id.Name),
+					}},
+				})
+			}
+
+			if w.isZeroValueDeclaration(stmt, id) {
+				delete(pending, obj) // declaring a variable with its zero value is not a dead store
+				continue
+			}
+			pending[obj] = store{node: stmt, name: id.Name}
+		}
+	}
+}
+
+// storedVars returns the identifiers to which the statement unconditionally assigns a value
+func (lintDeadStore) storedVars(stmt ast.Stmt) []*ast.Ident {
+	var result []*ast.Ident
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if s.Tok != token.ASSIGN && s.Tok != token.DEFINE {
+			return nil
+		}
+		for _, lhs := range s.Lhs {
+			if id, ok := lhs.(*ast.Ident); ok && !isBlank(id) {
+				result = append(result, id)
+			}
+		}
+	case *ast.DeclStmt:
+		decl, ok := s.Decl.(*ast.GenDecl)
+		if !ok || decl.Tok != token.VAR {
+			return nil
+		}
+		for _, spec := range decl.Specs {
+			vs, ok := spec.(*ast.ValueSpec)
+			if !ok || len(vs.Values) == 0 {
+				continue
+			}
+			for _, id := range vs.Names {
+				if !isBlank(id) {
+					result = append(result, id)
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// readVars returns the variables mentioned by the statement, other than through the given stored identifiers
+func (w lintDeadStore) readVars(stmt ast.Stmt, stored []*ast.Ident) map[types.Object]bool {
+	isStored := map[*ast.Ident]bool{}
+	for _, id := range stored {
+		isStored[id] = true
+	}
+
+	result := map[types.Object]bool{}
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && !isStored[id] {
+			if obj := w.info.ObjectOf(id); obj != nil {
+				result[obj] = true
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
+// isZeroValueDeclaration returns true if the statement declares the variable with a zero value (e.g. x := 0)
+func (w lintDeadStore) isZeroValueDeclaration(stmt ast.Stmt, id *ast.Ident) bool {
+	var value ast.Expr
+	switch s := stmt.(type) {
+	case *ast.AssignStmt:
+		if s.Tok != token.DEFINE || len(s.Lhs) != len(s.Rhs) {
+			return false
+		}
+		for i, lhs := range s.Lhs {
+			if lhs == id {
+				value = s.Rhs[i]
+			}
+		}
+	case *ast.DeclStmt:
+		for _, spec := range s.Decl.(*ast.GenDecl).Specs {
+			vs := spec.(*ast.ValueSpec)
+			for i, name := range vs.Names {
+				if name == id && len(vs.Names) == len(vs.Values) {
+					value = vs.Values[i]
+				}
+			}
+		}
+	}
+	if value == nil {
+		return false
+	}
+
+	tv, ok := w.info.Types[value]
+	if !ok {
+		return false
+	}
+	if tv.IsNil() {
+		return true
+	}
+	if tv.Value == nil {
+		return false
+	}
+	switch tv.Value.Kind() {
+	case constant.Bool:
+		return !constant.BoolVal(tv.Value)
+	case constant.String:
+		return constant.StringVal(tv.Value) == ""
+	case constant.Int, constant.Float, constant.Complex:
+		return constant.Sign(tv.Value) == 0
+	}
+
+	return false
+}
+
+// escapingVars returns the variables that can be read without being mentioned in the statements of the function body:
+// variables whose address is taken and variables used by function literals
+func (w lintDeadStore) escapingVars(body *ast.BlockStmt) map[types.Object]bool {
+	result := map[types.Object]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			ast.Inspect(n.Body, func(n ast.Node) bool {
+				if id, ok := n.(*ast.Ident); ok {
+					result[w.info.ObjectOf(id)] = true
+				}
+				return true
+			})
+			return false
+		case *ast.UnaryExpr:
+			if id, ok := unparen(n.X).(*ast.Ident); ok && n.Op == token.AND {
+				result[w.info.ObjectOf(id)] = true
+			}
+		case *ast.SelectorExpr:
+			// calling a method with a pointer receiver on an addressable value takes its address
+			id, ok := unparen(n.X).(*ast.Ident)
+			if !ok {
+				return true
+			}
+			sel, ok := w.info.Selections[n]
+			if !ok || sel.Kind() != types.MethodVal {
+				return true
+			}
+			if sig, ok := sel.Obj().Type().(*types.Signature); ok && sig.Recv() != nil {
+				if _, isPtr := sig.Recv().Type().(*types.Pointer); isPtr {
+					result[w.info.ObjectOf(id)] = true
+				}
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
+// hasJump returns true if the statement is labeled or contains a branch statement
+func hasJump(stmt ast.Stmt) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BranchStmt, *ast.LabeledStmt:
+			found = true
+		}
+		return !found
+	})
+
+	return found
+}
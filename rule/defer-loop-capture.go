@@ -0,0 +1,186 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// DeferLoopCaptureRule spots deferred closures, within loops, referencing loop variables.
+type DeferLoopCaptureRule struct {
+	perIterationLoopVars bool
+	configured           bool
+	sync.Mutex
+}
+
+func (r *DeferLoopCaptureRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	r.perIterationLoopVars = isPerIterationLoopVarsVersion(r.Name(), arguments)
+}
+
+// Apply applies the rule to given file.
+func (r *DeferLoopCaptureRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	inspectLoopClosures(info, file.AST, r.perIterationLoopVars, func(stmt ast.Stmt, captured []string) {
+		if _, ok := stmt.(*ast.DeferStmt); !ok {
+			return
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "logic",
+			Confidence: 1,
+			Node:       stmt,
+			Failure:    fmt.Sprintf("deferred closure captures the loop variable(s) %s, all the deferred calls will see their last value; pass them as arguments of the closure", strings.Join(captured, ", ")),
+		})
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*DeferLoopCaptureRule) Name() string {
+	return "defer-loop-capture"
+}
+
+// isPerIterationLoopVarsVersion returns true if the Go version given as first argument of the rule creates the loop variables at each iteration
+func isPerIterationLoopVarsVersion(ruleName string, arguments lint.Arguments) bool {
+	if len(arguments) < 1 {
+		return false
+	}
+
+	version, ok := arguments[0].(string)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a Go version string, got %T", ruleName, arguments[0]))
+	}
+	major, minor, ok := parseGoVersion(version)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a Go version like \"1.21\"", version, ruleName))
+	}
+
+	// since Go 1.22, variables declared by loops are created at each iteration
+	return major > 1 || (major == 1 && minor >= 22)
+}
+
+// inspectLoopClosures calls onCapture for each go or defer statement, within a loop, calling a function literal that references variables of the loop.
+// If perIterationLoopVars is set, the loops declaring their variables are skipped.
+func inspectLoopClosures(info *types.Info, root ast.Node, perIterationLoopVars bool, onCapture func(stmt ast.Stmt, captured []string)) {
+	ast.Inspect(root, func(n ast.Node) bool {
+		var body *ast.BlockStmt
+		var vars []ast.Expr
+		var declared bool // the loop declares its variables (:=)
+		switch loop := n.(type) {
+		case *ast.RangeStmt:
+			body, vars, declared = loop.Body, []ast.Expr{loop.Key, loop.Value}, loop.Tok == token.DEFINE
+		case *ast.ForStmt:
+			body = loop.Body
+			if init, ok := loop.Init.(*ast.AssignStmt); ok && init.Tok == token.DEFINE {
+				vars, declared = init.Lhs, true
+			}
+		default:
+			return true
+		}
+
+		if declared && perIterationLoopVars {
+			return true
+		}
+
+		loopVars := map[types.Object]bool{}
+		for _, v := range vars {
+			id, ok := v.(*ast.Ident)
+			if !ok || isBlank(id) {
+				continue
+			}
+			if obj := info.ObjectOf(id); obj != nil {
+				loopVars[obj] = true
+			}
+		}
+		if len(loopVars) == 0 {
+			return true
+		}
+
+		ast.Inspect(body, func(n ast.Node) bool {
+			var call *ast.CallExpr
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false // defers within function literals run at the end of each call
+			case *ast.DeferStmt:
+				call = n.Call
+			case *ast.GoStmt:
+				call = n.Call
+			default:
+				return true
+			}
+
+			lit, ok := call.Fun.(*ast.FuncLit)
+			if !ok {
+				return false // arguments of go and deferred calls are evaluated immediately
+			}
+			if captured := capturedVars(info, lit, loopVars); len(captured) > 0 {
+				onCapture(n.(ast.Stmt), captured)
+			}
+			return false
+		})
+
+		return true
+	})
+}
+
+// capturedVars returns the names, in order of first reference, of the given variables referenced by the function literal
+func capturedVars(info *types.Info, lit *ast.FuncLit, vars map[types.Object]bool) []string {
+	var result []string
+	seen := map[types.Object]bool{}
+	ast.Inspect(lit.Body, func(n ast.Node) bool {
+		id, ok := n.(*ast.Ident)
+		if !ok {
+			return true
+		}
+		if obj := info.Uses[id]; vars[obj] && !seen[obj] {
+			seen[obj] = true
+			result = append(result, id.Name)
+		}
+		return true
+	})
+
+	return result
+}
+
+// parseGoVersion parses versions like 1.21, 1.21.3 or go1.21
+func parseGoVersion(version string) (major, minor int, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(version, "go"), ".")
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return major, minor, true
+}
@@ -0,0 +1,113 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// DeferUnlockRule spots deferred unlocks of mutexes that were not locked in the same function.
+type DeferUnlockRule struct{}
+
+// Apply applies the rule to given file.
+func (*DeferUnlockRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintDeferUnlock{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*DeferUnlockRule) Name() string {
+	return "defer-unlock"
+}
+
+type lintDeferUnlock struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+// lockFor maps unlocking methods to the locking methods they release
+var lockFor = map[string][]string{
+	"Unlock":  {"Lock", "TryLock"},
+	"RUnlock": {"RLock", "TryRLock"},
+}
+
+func (w lintDeferUnlock) Visit(node ast.Node) ast.Visitor {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	// locks maps mutexes to the lock methods called on them so far
+	locks := map[string]map[string]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.DeferStmt:
+			mutex, method, ok := syncMethodCall(w.file.Pkg, n.Call)
+			if !ok || len(lockFor[method]) == 0 {
+				return false
+			}
+
+			for _, lock := range lockFor[method] {
+				if locks[mutex][lock] {
+					return false
+				}
+			}
+
+			w.onFailure(lint.Failure{
+				Category:   "logic",
+				Confidence: 0.8,
+				Node:       n,
+				Failure:    fmt.Sprintf("deferred call to %s.%s without a preceding call to %s.%s in the same function", mutex, method, mutex, lockFor[method][0]),
+			})
+			return false
+		case *ast.CallExpr:
+			if mutex, method, ok := syncMethodCall(w.file.Pkg, n); ok {
+				if locks[mutex] == nil {
+					locks[mutex] = map[string]bool{}
+				}
+				locks[mutex][method] = true
+			}
+		}
+		return true
+	})
+
+	return w
+}
+
+// syncMethodCall returns the receiver and the method of a call to a method of the sync package (like mu.Lock()).
+func syncMethodCall(pkg *lint.Package, call *ast.CallExpr) (mutex, method string, ok bool) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	fn, ok := pkg.TypesInfo().Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "sync" {
+		return "", "", false
+	}
+
+	return gofmt(sel.X), sel.Sel.Name, true
+}
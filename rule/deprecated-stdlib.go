@@ -0,0 +1,132 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// defaultDeprecatedSymbols maps deprecated symbols of the standard library, in the form "importpath.Symbol", to their replacement
+var defaultDeprecatedSymbols = map[string]string{
+	"io/ioutil.Discard":               "io.Discard",
+	"io/ioutil.NopCloser":             "io.NopCloser",
+	"io/ioutil.ReadAll":               "io.ReadAll",
+	"io/ioutil.ReadDir":               "os.ReadDir",
+	"io/ioutil.ReadFile":              "os.ReadFile",
+	"io/ioutil.TempDir":               "os.MkdirTemp",
+	"io/ioutil.TempFile":              "os.CreateTemp",
+	"io/ioutil.WriteFile":             "os.WriteFile",
+	"os.SEEK_SET":                     "io.SeekStart",
+	"os.SEEK_CUR":                     "io.SeekCurrent",
+	"os.SEEK_END":                     "io.SeekEnd",
+	"strings.Title":                   "golang.org/x/text/cases",
+	"bytes.Title":                     "golang.org/x/text/cases",
+	"math/rand.Seed":                  "rand.New(rand.NewSource(seed))",
+	"math/rand.Read":                  "crypto/rand.Read",
+	"reflect.SliceHeader":             "unsafe.Slice or unsafe.SliceData",
+	"reflect.StringHeader":            "unsafe.String or unsafe.StringData",
+	"crypto/x509.IsEncryptedPEMBlock": "a modern encryption of private keys (e.g. PKCS #8)",
+	"crypto/x509.DecryptPEMBlock":     "a modern encryption of private keys (e.g. PKCS #8)",
+	"crypto/x509.EncryptPEMBlock":     "a modern encryption of private keys (e.g. PKCS #8)",
+	"crypto/elliptic.Marshal":         "crypto/ecdh",
+	"crypto/elliptic.Unmarshal":       "crypto/ecdh",
+	"crypto/elliptic.GenerateKey":     "crypto/ecdh",
+}
+
+// DeprecatedStdlibRule spots usages of deprecated symbols of the standard library.
+type DeprecatedStdlibRule struct {
+	deprecated map[string]map[string]string // import path -> symbol -> replacement
+	sync.Mutex
+}
+
+func (r *DeprecatedStdlibRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.deprecated != nil {
+		return
+	}
+
+	symbols := make(map[string]string, len(defaultDeprecatedSymbols))
+	for symbol, replacement := range defaultDeprecatedSymbols {
+		symbols[symbol] = replacement
+	}
+
+	if len(arguments) > 0 {
+		checkNumberOfArguments(1, arguments, r.Name())
+		args, ok := arguments[0].(map[string]any)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+		}
+		for symbol, v := range args {
+			replacement, ok := v.(string)
+			if !ok || !strings.Contains(symbol, ".") {
+				panic(fmt.Sprintf("Invalid argument for '%s' rule. Expecting \"importpath.Symbol\" = \"replacement\", got %q = %v", r.Name(), symbol, v))
+			}
+			symbols[symbol] = replacement
+		}
+	}
+
+	r.deprecated = map[string]map[string]string{}
+	for symbol, replacement := range symbols {
+		i := strings.LastIndex(symbol, ".")
+		path, name := symbol[:i], symbol[i+1:]
+		if r.deprecated[path] == nil {
+			r.deprecated[path] = map[string]string{}
+		}
+		r.deprecated[path][name] = replacement
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *DeprecatedStdlibRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	// deprecated maps the names under which packages are imported in the file to their deprecated symbols
+	deprecated := map[string]map[string]string{}
+	for path, symbols := range r.deprecated {
+		if name := importName(file.AST, path); name != "" {
+			deprecated[name] = symbols
+		}
+	}
+	if len(deprecated) == 0 {
+		return nil
+	}
+
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		sel, ok := n.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		pkg, ok := sel.X.(*ast.Ident)
+		if !ok || pkg.Obj != nil { // a local variable, not a package
+			return true
+		}
+
+		replacement, ok := deprecated[pkg.Name][sel.Sel.Name]
+		if !ok {
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "bad practice",
+			Confidence: 1,
+			Node:       sel,
+			Failure:    fmt.Sprintf("%s is deprecated, use %s instead", gofmt(sel), replacement),
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*DeprecatedStdlibRule) Name() string {
+	return "deprecated-stdlib"
+}
@@ -0,0 +1,98 @@
+package rule
+
+import (
+	"fmt"
+	"path/filepath"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const (
+	docGoModeIfPresent = "ifPresent"
+	docGoModeAlways    = "always"
+	docGoModeNever     = "never"
+)
+
+// DocGoPackageCommentRule enforces that package comments live (or, in never mode, do not live) in doc.go files.
+type DocGoPackageCommentRule struct {
+	configured bool
+	mode       string
+	sync.Mutex
+}
+
+func (r *DocGoPackageCommentRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	r.mode = docGoModeIfPresent
+	if len(arguments) < 1 {
+		return
+	}
+
+	checkNumberOfArguments(1, arguments, r.Name())
+	mode, ok := arguments[0].(string)
+	if !ok || (mode != docGoModeIfPresent && mode != docGoModeAlways && mode != docGoModeNever) {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q, %q or %q", arguments[0], r.Name(), docGoModeIfPresent, docGoModeAlways, docGoModeNever))
+	}
+	r.mode = mode
+}
+
+// Apply applies the rule to given file.
+func (r *DocGoPackageCommentRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if file.IsTest() {
+		return nil
+	}
+
+	isDocGo := filepath.Base(file.Name) == "doc.go"
+	if r.mode == docGoModeNever {
+		if !isDocGo || file.AST.Doc == nil {
+			return nil
+		}
+		return []lint.Failure{{
+			Category:   "comments",
+			Confidence: 1,
+			Node:       file.AST.Doc,
+			Failure:    "the package comment should not be in doc.go",
+		}}
+	}
+
+	hasDocGo := false
+	for name, f := range file.Pkg.Files() {
+		if filepath.Base(name) == "doc.go" && !f.IsTest() {
+			hasDocGo = true
+			break
+		}
+	}
+
+	switch {
+	case isDocGo && file.AST.Doc == nil:
+		return []lint.Failure{{
+			Category:   "comments",
+			Confidence: 1,
+			Node:       file.AST.Name,
+			Failure:    "doc.go should contain the package comment",
+		}}
+	case !isDocGo && file.AST.Doc != nil && (hasDocGo || r.mode == docGoModeAlways):
+		return []lint.Failure{{
+			Category:   "comments",
+			Confidence: 1,
+			Node:       file.AST.Doc,
+			Failure:    "the package comment should be in doc.go",
+		}}
+	}
+
+	return nil
+}
+
+// Name returns the rule name.
+func (*DocGoPackageCommentRule) Name() string {
+	return "doc-go-package-comment"
+}
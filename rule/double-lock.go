@@ -0,0 +1,76 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// DoubleLockRule spots mutexes locked again, in the same function, before being unlocked.
+type DoubleLockRule struct{}
+
+// Apply applies the rule to given file.
+func (*DoubleLockRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintDoubleLock{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*DoubleLockRule) Name() string {
+	return "double-lock"
+}
+
+type lintDoubleLock struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintDoubleLock) Visit(node ast.Node) ast.Visitor {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	a := &lockAnalyzer{
+		file: w.file,
+		onLock: func(call *ast.CallExpr, mutex, method string, state *lockState) {
+			// read locks can be held several times, thus only (write) locks are checked
+			if method != "Lock" {
+				return
+			}
+			if _, held := state.held[mutex+"#"+lockKeys[method]]; !held {
+				return
+			}
+
+			w.onFailure(lint.Failure{
+				Category:   "logic",
+				Confidence: 1,
+				Node:       call,
+				Failure:    fmt.Sprintf("%s is locked again before being unlocked, this deadlocks because mutexes are not reentrant", mutex),
+			})
+		},
+	}
+	a.block(body.List, newLockState())
+
+	return w
+}
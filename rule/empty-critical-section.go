@@ -0,0 +1,81 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// EmptyCriticalSectionRule spots mutexes unlocked right after being locked.
+type EmptyCriticalSectionRule struct{}
+
+// Apply applies the rule to given file.
+func (*EmptyCriticalSectionRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	check := func(stmts []ast.Stmt) {
+		for i := 1; i < len(stmts); i++ {
+			lockMutex, lock, ok := syncMethodCallStmt(file.Pkg, stmts[i-1])
+			if !ok {
+				continue
+			}
+			unlockMutex, unlock, ok := syncMethodCallStmt(file.Pkg, stmts[i])
+			if !ok || unlockMutex != lockMutex || !isLockReleasedBy(lock, unlock) {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "logic",
+				Confidence: 0.8,
+				Position:   lint.ToFailurePosition(stmts[i-1].Pos(), stmts[i].End(), file),
+				Failure:    fmt.Sprintf("empty critical section: %s.%s() is immediately followed by %s.%s(), remove them or move the guarded code between them", lockMutex, lock, unlockMutex, unlock),
+			})
+		}
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BlockStmt:
+			check(n.List)
+		case *ast.CaseClause:
+			check(n.Body)
+		case *ast.CommClause:
+			check(n.Body)
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*EmptyCriticalSectionRule) Name() string {
+	return "empty-critical-section"
+}
+
+// syncMethodCallStmt returns the mutex and the method of a statement calling a method of the sync package
+func syncMethodCallStmt(pkg *lint.Package, stmt ast.Stmt) (mutex, method string, ok bool) {
+	exprStmt, ok := stmt.(*ast.ExprStmt)
+	if !ok {
+		return "", "", false
+	}
+	call, ok := exprStmt.X.(*ast.CallExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	return syncMethodCall(pkg, call)
+}
+
+// isLockReleasedBy returns true if the given unlocking method releases the given locking method
+func isLockReleasedBy(lock, unlock string) bool {
+	for _, l := range lockFor[unlock] {
+		if l == lock {
+			return true
+		}
+	}
+	return false
+}
@@ -4,22 +4,72 @@ import (
 	"fmt"
 	"go/ast"
 	"go/token"
+	"go/types"
 	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/mgechev/revive/lint"
 )
 
-// ErrorNamingRule lints given else constructs.
-type ErrorNamingRule struct{}
+// ErrorNamingRule lints the naming of package-level error variables.
+type ErrorNamingRule struct {
+	prefix         string
+	checkNonErrors bool
+	sync.Mutex
+}
+
+const defaultErrorPrefix = "Err"
+
+func (r *ErrorNamingRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.prefix != "" {
+		return
+	}
+
+	r.prefix = defaultErrorPrefix
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		switch k {
+		case "prefix":
+			prefix, ok := v.(string)
+			if !ok || prefix == "" {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting prefix to be a non-empty string, got %v", r.Name(), v))
+			}
+			r.prefix = strings.ToUpper(prefix[:1]) + prefix[1:]
+		case "checkNonErrors":
+			check, ok := v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting checkNonErrors to be a boolean, got %T", r.Name(), v))
+			}
+			r.checkNonErrors = check
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
 
 // Apply applies the rule to given file.
-func (*ErrorNamingRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+func (r *ErrorNamingRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
 	var failures []lint.Failure
 
 	fileAst := file.AST
 	walker := lintErrors{
-		file:    file,
-		fileAst: fileAst,
+		file:           file,
+		fileAst:        fileAst,
+		prefix:         r.prefix,
+		checkNonErrors: r.checkNonErrors,
 		onFailure: func(failure lint.Failure) {
 			failures = append(failures, failure)
 		},
@@ -36,12 +86,18 @@ func (*ErrorNamingRule) Name() string {
 }
 
 type lintErrors struct {
-	file      *lint.File
-	fileAst   *ast.File
-	onFailure func(lint.Failure)
+	file           *lint.File
+	fileAst        *ast.File
+	prefix         string // prefix of exported error vars, the prefix of unexported ones starts with a lower case
+	checkNonErrors bool
+	onFailure      func(lint.Failure)
 }
 
 func (w lintErrors) Visit(_ ast.Node) ast.Visitor {
+	if w.checkNonErrors {
+		w.file.Pkg.TypeCheck()
+	}
+
 	for _, decl := range w.fileAst.Decls {
 		gd, ok := decl.(*ast.GenDecl)
 		if !ok || gd.Tok != token.VAR {
@@ -49,6 +105,9 @@ func (w lintErrors) Visit(_ ast.Node) ast.Visitor {
 		}
 		for _, spec := range gd.Specs {
 			spec := spec.(*ast.ValueSpec)
+			if w.checkNonErrors {
+				w.checkNonErrorVars(spec)
+			}
 			if len(spec.Names) != 1 || len(spec.Values) != 1 {
 				continue
 			}
@@ -61,10 +120,7 @@ func (w lintErrors) Visit(_ ast.Node) ast.Visitor {
 			}
 
 			id := spec.Names[0]
-			prefix := "err"
-			if id.IsExported() {
-				prefix = "Err"
-			}
+			prefix := w.prefixOf(id)
 			if !strings.HasPrefix(id.Name, prefix) {
 				w.onFailure(lint.Failure{
 					Node:       id,
@@ -77,3 +133,40 @@ func (w lintErrors) Visit(_ ast.Node) ast.Visitor {
 	}
 	return nil
 }
+
+// prefixOf returns the prefix the name of the given error var must have
+func (w lintErrors) prefixOf(id *ast.Ident) string {
+	if id.IsExported() {
+		return w.prefix
+	}
+	return strings.ToLower(w.prefix[:1]) + w.prefix[1:]
+}
+
+// checkNonErrorVars spots vars named as error vars but not holding errors
+func (w lintErrors) checkNonErrorVars(spec *ast.ValueSpec) {
+	pkg := w.file.Pkg.TypesPkg()
+	if pkg == nil {
+		return
+	}
+
+	for _, id := range spec.Names {
+		prefix := w.prefixOf(id)
+		rest := strings.TrimPrefix(id.Name, prefix)
+		if rest == id.Name || rest == "" || !unicode.IsUpper([]rune(rest)[0]) {
+			continue // not named as an error var (e.g. errand)
+		}
+
+		obj := pkg.Scope().Lookup(id.Name)
+		if obj == nil || obj.Pos() != id.Pos() || implementsError(obj.Type()) {
+			continue
+		}
+
+		typeName := types.TypeString(obj.Type(), func(p *types.Package) string { return p.Name() })
+		w.onFailure(lint.Failure{
+			Node:       id,
+			Confidence: 0.9,
+			Category:   "naming",
+			Failure:    fmt.Sprintf("var %s is named as an error var but is of type %s, which does not implement error", id.Name, typeName),
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ErrorStringComparisonRule spots comparisons of error messages against string literals.
+type ErrorStringComparisonRule struct{}
+
+// Apply applies the rule to given file.
+func (*ErrorStringComparisonRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := &lintErrorStringComparison{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ErrorStringComparisonRule) Name() string {
+	return "error-string-comparison"
+}
+
+type lintErrorStringComparison struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w *lintErrorStringComparison) Visit(node ast.Node) ast.Visitor {
+	expr, ok := node.(*ast.BinaryExpr)
+	if !ok {
+		return w
+	}
+
+	switch expr.Op {
+	case token.EQL, token.NEQ:
+	default:
+		return w
+	}
+
+	errExpr := w.errorMessageReceiver(expr.X)
+	if errExpr == nil || !isStringLiteral(expr.Y) {
+		errExpr = w.errorMessageReceiver(expr.Y)
+		if errExpr == nil || !isStringLiteral(expr.X) {
+			return w
+		}
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "errors",
+		Confidence: 1,
+		Node:       expr,
+		Failure:    fmt.Sprintf("comparing the message of %s with a string literal is brittle, use a sentinel error and errors.Is instead", gofmt(errExpr)),
+	})
+
+	return w
+}
+
+// errorMessageReceiver returns the receiver of the expression if the expression
+// is a call to the Error method of an error, nil otherwise.
+func (w *lintErrorStringComparison) errorMessageReceiver(expr ast.Expr) ast.Expr {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok || len(call.Args) != 0 {
+		return nil
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" {
+		return nil
+	}
+
+	if !implementsError(w.file.Pkg.TypeOf(sel.X)) {
+		return nil
+	}
+
+	return sel.X
+}
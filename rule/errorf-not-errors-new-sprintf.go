@@ -0,0 +1,76 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ErrorfNotErrorsNewSprintfRule spots errors.New(fmt.Sprintf(...)) calls.
+type ErrorfNotErrorsNewSprintfRule struct{}
+
+// Apply applies the rule to given file.
+func (*ErrorfNotErrorsNewSprintfRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintErrorfNotErrorsNewSprintf{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ErrorfNotErrorsNewSprintfRule) Name() string {
+	return "errorf-not-errors-new-sprintf"
+}
+
+type lintErrorfNotErrorsNewSprintf struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintErrorfNotErrorsNewSprintf) Visit(node ast.Node) ast.Visitor {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !w.isFunc(call.Fun, "errors", "New") {
+		return w
+	}
+
+	sprintf, ok := call.Args[0].(*ast.CallExpr)
+	if !ok || len(sprintf.Args) == 0 || sprintf.Ellipsis.IsValid() || !w.isFunc(sprintf.Fun, "fmt", "Sprintf") {
+		return w
+	}
+
+	fmtPkg := gofmt(sprintf.Fun.(*ast.SelectorExpr).X)
+	msg := fmt.Sprintf("use %s.Errorf(...) instead of %s(%s(...))", fmtPkg, gofmt(call.Fun), gofmt(sprintf.Fun))
+	if len(sprintf.Args) == 1 {
+		msg = fmt.Sprintf("unnecessary call to %s, use %s(%s) instead", gofmt(sprintf.Fun), gofmt(call.Fun), gofmt(sprintf.Args[0]))
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "errors",
+		Confidence: 1,
+		Node:       call,
+		Failure:    msg,
+	})
+
+	return w
+}
+
+// isFunc returns true if the given expression refers to the function name of the package pkg
+func (w lintErrorfNotErrorsNewSprintf) isFunc(expr ast.Expr, pkg, name string) bool {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return false
+	}
+
+	fn, ok := w.file.Pkg.TypesInfo().Uses[sel.Sel].(*types.Func)
+	return ok && fn.Pkg() != nil && fn.Pkg().Path() == pkg
+}
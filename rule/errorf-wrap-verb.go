@@ -0,0 +1,140 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ErrorfWrapVerbRule spots errors formatted by fmt.Errorf with a verb other than %w.
+type ErrorfWrapVerbRule struct {
+	ignoredVerbs map[byte]bool
+	sync.Mutex
+}
+
+func (r *ErrorfWrapVerbRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ignoredVerbs != nil {
+		return
+	}
+
+	r.ignoredVerbs = map[byte]bool{}
+	for _, arg := range arguments {
+		verb, ok := arg.(string)
+		verb = strings.TrimPrefix(verb, "%")
+		if !ok || len(verb) != 1 {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a verb like \"%%s\"", arg, r.Name()))
+		}
+		r.ignoredVerbs[verb[0]] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *ErrorfWrapVerbRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	if file.Pkg.TypesPkg() == nil {
+		return nil
+	}
+	info := file.Pkg.TypesInfo()
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Errorf" {
+			return true
+		}
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return true
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+
+		verbs, ok := formatVerbs(format)
+		if !ok {
+			return true // explicit argument indexes are not supported
+		}
+
+		args := call.Args[1:]
+		for i, verb := range verbs {
+			if i >= len(args) {
+				break
+			}
+			if verb == 0 || verb == 'w' || verb == 'T' || verb == 'p' || r.ignoredVerbs[verb] {
+				continue
+			}
+			if !implementsError(file.Pkg.TypeOf(args[i])) {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "errors",
+				Confidence: 0.8,
+				Node:       args[i],
+				Failure:    fmt.Sprintf("error %s is formatted with %%%c, use %%w to wrap it instead", gofmt(args[i]), verb),
+			})
+		}
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ErrorfWrapVerbRule) Name() string {
+	return "errorf-wrap-verb"
+}
+
+// formatVerbs returns, for each argument consumed by the given format string, the verb formatting it
+// (0 for the arguments consumed by a * width or precision).
+// It returns false if the format uses explicit argument indexes.
+func formatVerbs(format string) ([]byte, bool) {
+	var verbs []byte
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		// skip flags, width and precision
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.*[]", format[i]) >= 0 {
+			switch format[i] {
+			case '[':
+				return nil, false
+			case '*':
+				verbs = append(verbs, 0)
+			}
+			i++
+		}
+
+		if i < len(format) && format[i] != '%' {
+			verbs = append(verbs, format[i])
+		}
+	}
+
+	return verbs, true
+}
@@ -0,0 +1,95 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"regexp"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// defaultErrorDocPattern matches doc comments mentioning errors (error, errors, err, ErrNotFound...)
+const defaultErrorDocPattern = `(?i)\berr`
+
+// ExportedErrorDocRule spots doc comments of exported functions returning an error that say nothing about errors.
+type ExportedErrorDocRule struct {
+	pattern *regexp.Regexp
+	sync.Mutex
+}
+
+func (r *ExportedErrorDocRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.pattern != nil {
+		return
+	}
+
+	pattern := defaultErrorDocPattern
+	if len(arguments) > 0 {
+		var ok bool
+		pattern, ok = arguments[0].(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arguments[0]))
+		}
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a valid regular expression, got %q: %v", r.Name(), pattern, err))
+	}
+	r.pattern = re
+}
+
+// Apply applies the rule to given file.
+func (r *ExportedErrorDocRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Doc == nil || !fn.Name.IsExported() || !returnsError(fn) {
+			continue // undocumented functions are reported by the exported rule
+		}
+		if fn.Recv != nil && !isExportedReceiver(fn) {
+			continue
+		}
+		if r.pattern.MatchString(fn.Doc.Text()) {
+			continue
+		}
+
+		kind := "function"
+		if fn.Recv != nil {
+			kind = "method"
+		}
+		failures = append(failures, lint.Failure{
+			Category:   "comments",
+			Confidence: 0.8,
+			Node:       fn.Name,
+			Failure:    fmt.Sprintf("comment on exported %s %s should document the errors it returns", kind, fn.Name.Name),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ExportedErrorDocRule) Name() string {
+	return "exported-error-doc"
+}
+
+// returnsError returns true if one of the results of the function is an error
+func returnsError(fn *ast.FuncDecl) bool {
+	if fn.Type.Results == nil {
+		return false
+	}
+
+	for _, field := range fn.Type.Results.List {
+		if isIdent(field.Type, "error") {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,130 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/internal/typeparams"
+	"github.com/mgechev/revive/lint"
+)
+
+const allowInterfaceMethodsArgument = "allowInterfaceMethods"
+
+// ExportedMethodUnexportedTypeRule spots exported methods declared on unexported types.
+type ExportedMethodUnexportedTypeRule struct {
+	configured            bool
+	allowInterfaceMethods bool
+	sync.Mutex
+}
+
+func (r *ExportedMethodUnexportedTypeRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	for _, arg := range arguments {
+		flag, ok := arg.(string)
+		if !ok || flag != allowInterfaceMethodsArgument {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q", arg, r.Name(), allowInterfaceMethodsArgument))
+		}
+		r.allowInterfaceMethods = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *ExportedMethodUnexportedTypeRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	interfaces := exportedInterfaces(file.Pkg.TypesPkg())
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || !fn.Name.IsExported() {
+			continue
+		}
+
+		recvType := typeparams.ReceiverType(fn)
+		if recvType == "" || ast.IsExported(recvType) {
+			continue
+		}
+
+		isInterfaceMethod := implementsInterfaceMethod(file.Pkg.TypeOf(fn.Recv.List[0].Type), fn.Name.Name, interfaces)
+		if r.allowInterfaceMethods && isInterfaceMethod {
+			continue
+		}
+
+		msg := fmt.Sprintf("exported method %s of unexported type %s is not reachable from other packages", fn.Name.Name, recvType)
+		if isInterfaceMethod {
+			msg = fmt.Sprintf("exported method %s of unexported type %s is only reachable through interfaces", fn.Name.Name, recvType)
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "unexported-type-in-api",
+			Confidence: 0.8,
+			Node:       fn.Name,
+			Failure:    msg,
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ExportedMethodUnexportedTypeRule) Name() string {
+	return "exported-method-unexported-type"
+}
+
+// exportedInterfaces returns the exported interfaces declared in the given package and in the packages it imports,
+// along with the error interface
+func exportedInterfaces(pkg *types.Package) []*types.Interface {
+	result := []*types.Interface{types.Universe.Lookup("error").Type().Underlying().(*types.Interface)}
+	if pkg == nil {
+		return result
+	}
+
+	for _, p := range append([]*types.Package{pkg}, pkg.Imports()...) {
+		scope := p.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || !obj.Exported() {
+				continue
+			}
+			if iface, ok := obj.Type().Underlying().(*types.Interface); ok && iface.NumMethods() > 0 {
+				result = append(result, iface)
+			}
+		}
+	}
+
+	return result
+}
+
+// implementsInterfaceMethod returns true if the given receiver type implements one of the interfaces
+// and the method is part of that interface
+func implementsInterfaceMethod(recv types.Type, method string, interfaces []*types.Interface) bool {
+	if recv == nil {
+		return false
+	}
+
+	for _, iface := range interfaces {
+		if !types.Implements(recv, iface) {
+			continue
+		}
+		for i := 0; i < iface.NumMethods(); i++ {
+			if iface.Method(i).Name() == method {
+				return true
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,78 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// FlagArgumentRule lints functions with too many boolean parameters.
+type FlagArgumentRule struct {
+	max int
+	sync.Mutex
+}
+
+const defaultFlagArgumentsLimit = 2
+
+func (r *FlagArgumentRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+	if r.max == 0 {
+		if len(arguments) < 1 {
+			r.max = defaultFlagArgumentsLimit
+			return
+		}
+		max, ok := arguments[0].(int64)
+		if !ok {
+			panic(fmt.Sprintf(`invalid value passed as boolean parameters threshold to the "flag-argument" rule; need int64 but got %T`, arguments[0]))
+		}
+		if max < 1 {
+			panic(`the value passed as boolean parameters threshold to the "flag-argument" rule must be greater than 0`)
+		}
+		r.max = int(max)
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *FlagArgumentRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		fd, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+
+		count := 0
+		for _, p := range fd.Type.Params.List {
+			if !isIdent(p.Type, "bool") {
+				continue
+			}
+			if len(p.Names) == 0 {
+				count++
+			}
+			count += len(p.Names)
+		}
+
+		if count < r.max {
+			continue
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "bad practice",
+			Confidence: 1,
+			Node:       fd.Type,
+			Failure:    fmt.Sprintf("function %s has %d boolean parameters, consider using an options struct or named constants instead", fd.Name.Name, count),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*FlagArgumentRule) Name() string {
+	return "flag-argument"
+}
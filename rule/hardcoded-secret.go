@@ -0,0 +1,234 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const defaultSecretNamePattern = `(?i)(password|passwd|secret|token|api_?key)`
+
+var defaultSecretValuePatterns = []string{
+	`AKIA[0-9A-Z]{16}`,                   // AWS access key ID
+	`gh[pousr]_[A-Za-z0-9]{36}`,          // GitHub token
+	`xox[abprs]-[0-9A-Za-z-]{10,}`,       // Slack token
+	`-----BEGIN [A-Z ]*PRIVATE KEY-----`, // private key
+	`sk_live_[0-9a-zA-Z]{24,}`,           // Stripe secret key
+}
+
+// HardcodedSecretRule spots string literals that look like hardcoded credentials.
+type HardcodedSecretRule struct {
+	configured    bool
+	namePattern   *regexp.Regexp
+	valuePatterns []*regexp.Regexp
+	allowedValues map[string]bool
+	ignoreTests   bool
+	sync.Mutex
+}
+
+func (r *HardcodedSecretRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	r.namePattern = regexp.MustCompile(defaultSecretNamePattern)
+	r.valuePatterns = r.compilePatterns("valuePatterns", defaultSecretValuePatterns)
+	r.allowedValues = map[string]bool{}
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+	}
+
+	for k, v := range args {
+		switch k {
+		case "namePattern":
+			pattern, ok := v.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a string, got %T", v, k, r.Name(), v))
+			}
+			r.namePattern = r.compilePatterns(k, []string{pattern})[0]
+		case "valuePatterns":
+			r.valuePatterns = r.compilePatterns(k, r.toStrings(k, v))
+		case "allowedValues":
+			for _, value := range r.toStrings(k, v) {
+				r.allowedValues[value] = true
+			}
+		case "ignoreTests":
+			r.ignoreTests, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a boolean, got %T", v, k, r.Name(), v))
+			}
+		default:
+			panic(fmt.Sprintf("Unknown argument '%s' for rule '%s'", k, r.Name()))
+		}
+	}
+}
+
+func (r *HardcodedSecretRule) toStrings(arg string, v any) []string {
+	values, ok := v.([]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a list of strings, got %T", v, arg, r.Name(), v))
+	}
+
+	result := make([]string, 0, len(values))
+	for _, value := range values {
+		s, ok := value.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a string, got %T", value, arg, r.Name(), value))
+		}
+		result = append(result, s)
+	}
+
+	return result
+}
+
+func (r *HardcodedSecretRule) compilePatterns(arg string, patterns []string) []*regexp.Regexp {
+	result := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid value for argument '%s' of rule '%s': regexp %q does not compile: %v", arg, r.Name(), pattern, err))
+		}
+		result = append(result, re)
+	}
+
+	return result
+}
+
+// Apply applies the rule to given file.
+func (r *HardcodedSecretRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if r.ignoreTests && file.IsTest() {
+		return nil
+	}
+
+	var failures []lint.Failure
+	w := &lintHardcodedSecret{
+		rule:     r,
+		reported: map[*ast.BasicLit]bool{},
+		onFailure: func(failure lint.Failure) {
+			failures = append(failures, failure)
+		},
+	}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*HardcodedSecretRule) Name() string {
+	return "hardcoded-secret"
+}
+
+type lintHardcodedSecret struct {
+	rule      *HardcodedSecretRule
+	reported  map[*ast.BasicLit]bool
+	onFailure func(lint.Failure)
+}
+
+func (w *lintHardcodedSecret) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.ValueSpec:
+		for i, value := range n.Values {
+			if i < len(n.Names) {
+				w.checkNamed(n.Names[i].Name, value)
+			}
+		}
+	case *ast.AssignStmt:
+		if len(n.Lhs) != len(n.Rhs) {
+			break
+		}
+		for i, lhs := range n.Lhs {
+			if name := assigneeName(lhs); name != "" {
+				w.checkNamed(name, n.Rhs[i])
+			}
+		}
+	case *ast.KeyValueExpr:
+		if key, ok := n.Key.(*ast.Ident); ok {
+			w.checkNamed(key.Name, n.Value)
+		}
+	case *ast.BasicLit:
+		w.checkValue(n)
+	}
+
+	return w
+}
+
+// assigneeName returns the name of an identifier or of the selected field of a selector expression
+func assigneeName(expr ast.Expr) string {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e.Name
+	case *ast.SelectorExpr:
+		return e.Sel.Name
+	}
+
+	return ""
+}
+
+// checkNamed reports non-empty string literals assigned to identifiers with a secret-like name
+func (w *lintHardcodedSecret) checkNamed(name string, expr ast.Expr) {
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING || !w.rule.namePattern.MatchString(name) {
+		return
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil || value == "" || w.rule.allowedValues[value] {
+		return
+	}
+
+	if w.rule.namePattern.MatchString(value) {
+		return // most likely the name of the secret, not the secret itself (as in passwordKey = "password")
+	}
+
+	w.report(lit, fmt.Sprintf("string literal assigned to %s looks like a hardcoded secret", name))
+}
+
+// checkValue reports string literals matching the format of known secrets
+func (w *lintHardcodedSecret) checkValue(lit *ast.BasicLit) {
+	if lit.Kind != token.STRING {
+		return
+	}
+
+	value, err := strconv.Unquote(lit.Value)
+	if err != nil || w.rule.allowedValues[value] {
+		return
+	}
+
+	for _, re := range w.rule.valuePatterns {
+		if re.MatchString(value) {
+			w.report(lit, fmt.Sprintf("string literal matches the format of a secret (%s)", re.String()))
+			return
+		}
+	}
+}
+
+func (w *lintHardcodedSecret) report(lit *ast.BasicLit, failure string) {
+	if w.reported[lit] {
+		return
+	}
+	w.reported[lit] = true
+
+	w.onFailure(lint.Failure{
+		Category:   "security",
+		Confidence: 0.8,
+		Node:       lit,
+		Failure:    failure,
+	})
+}
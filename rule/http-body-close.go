@@ -0,0 +1,60 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// HTTPBodyCloseRule spots HTTP responses whose body is never closed.
+type HTTPBodyCloseRule struct{}
+
+// Apply applies the rule to given file.
+func (*HTTPBodyCloseRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintUnclosedResource{
+		file: file,
+		isResource: func(t types.Type) bool {
+			ptr, ok := t.(*types.Pointer)
+			return ok && isNamedType(ptr.Elem(), "net/http", "Response")
+		},
+		method: "Close",
+		owner: func(x ast.Expr) ast.Expr {
+			// resp.Body.Close()
+			sel, ok := x.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Body" {
+				return nil
+			}
+			return sel.X
+		},
+		onUnclosed: func(node ast.Node, name string, call *ast.CallExpr) {
+			msg := fmt.Sprintf("the body of %s is never closed, add defer %s.Body.Close() to release the connection", name, name)
+			if name == "" {
+				msg = fmt.Sprintf("the response returned by %s is discarded without closing its body, this leaks a connection", gofmt(call.Fun))
+			}
+			onFailure(lint.Failure{
+				Category:   "bad practice",
+				Confidence: 0.8,
+				Node:       node,
+				Failure:    msg,
+			})
+		},
+	}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*HTTPBodyCloseRule) Name() string {
+	return "http-body-close"
+}
@@ -0,0 +1,128 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const (
+	ifElseAssign = "assign"
+	ifElseReturn = "return"
+)
+
+// IfAssignToTernaryRule spots if-else statements whose branches both assign the same variable, or both return.
+type IfAssignToTernaryRule struct {
+	checks map[string]bool
+	sync.Mutex
+}
+
+func (r *IfAssignToTernaryRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.checks != nil {
+		return
+	}
+
+	if len(arguments) < 1 {
+		r.checks = map[string]bool{ifElseAssign: true, ifElseReturn: true}
+		return
+	}
+
+	r.checks = map[string]bool{}
+	for _, arg := range arguments {
+		check, ok := arg.(string)
+		if !ok || (check != ifElseAssign && check != ifElseReturn) {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q or %q", arg, r.Name(), ifElseAssign, ifElseReturn))
+		}
+		r.checks[check] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *IfAssignToTernaryRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		ifStmt, ok := n.(*ast.IfStmt)
+		if !ok {
+			return true
+		}
+
+		elseBlock, ok := ifStmt.Else.(*ast.BlockStmt)
+		if !ok || len(ifStmt.Body.List) != 1 || len(elseBlock.List) != 1 {
+			return true
+		}
+
+		var msg string
+		switch then := ifStmt.Body.List[0].(type) {
+		case *ast.AssignStmt:
+			otherwise, ok := elseBlock.List[0].(*ast.AssignStmt)
+			if !r.checks[ifElseAssign] || !ok || !isSameSingleAssignment(then, otherwise) || mayHaveSideEffects(otherwise.Rhs[0]) {
+				return true
+			}
+			if target := rootIdent(then.Lhs[0]); target == nil || refersTo(target.Name, ifStmt.Cond, then.Rhs[0], otherwise.Rhs[0]) {
+				return true // assigning before the if would change what the condition or the branches read
+			}
+			msg = fmt.Sprintf("%s is assigned in both branches of the if-else, consider assigning %s before the if and dropping the else", gofmt(then.Lhs[0]), gofmt(otherwise.Rhs[0]))
+		case *ast.ReturnStmt:
+			otherwise, ok := elseBlock.List[0].(*ast.ReturnStmt)
+			if !r.checks[ifElseReturn] || !ok {
+				return true
+			}
+			msg = fmt.Sprintf("both branches of the if-else return, consider dropping the else and ending with %s", gofmt(otherwise))
+		default:
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "style",
+			Confidence: 0.8,
+			Node:       ifStmt,
+			Failure:    msg,
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*IfAssignToTernaryRule) Name() string {
+	return "if-assign-to-ternary"
+}
+
+// isSameSingleAssignment returns true if both statements assign (with =) a single value to the same target
+func isSameSingleAssignment(a, b *ast.AssignStmt) bool {
+	if a.Tok != token.ASSIGN || b.Tok != token.ASSIGN {
+		return false
+	}
+
+	if len(a.Lhs) != 1 || len(b.Lhs) != 1 || len(a.Rhs) != 1 || len(b.Rhs) != 1 {
+		return false
+	}
+
+	return gofmt(a.Lhs[0]) == gofmt(b.Lhs[0])
+}
+
+// refersTo returns true if the given name is referenced by one of the expressions
+func refersTo(name string, exprs ...ast.Expr) bool {
+	found := false
+	for _, expr := range exprs {
+		ast.Inspect(expr, func(n ast.Node) bool {
+			if id, ok := n.(*ast.Ident); ok && id.Name == name {
+				found = true
+			}
+			return !found
+		})
+	}
+
+	return found
+}
@@ -0,0 +1,137 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sort"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ImplicitExportedMethodRule spots exported structs that promote methods of embedded unexported types.
+type ImplicitExportedMethodRule struct{}
+
+// Apply applies the rule to given file.
+func (*ImplicitExportedMethodRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := &lintImplicitExportedMethod{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ImplicitExportedMethodRule) Name() string {
+	return "implicit-exported-method"
+}
+
+type lintImplicitExportedMethod struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w *lintImplicitExportedMethod) Visit(node ast.Node) ast.Visitor {
+	spec, ok := node.(*ast.TypeSpec)
+	if !ok {
+		return w
+	}
+
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok || !spec.Name.IsExported() {
+		return nil
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	obj := info.Defs[spec.Name]
+	if obj == nil {
+		return nil
+	}
+
+	structType, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		return nil
+	}
+
+	methods := types.NewMethodSet(types.NewPointer(obj.Type()))
+	for _, field := range st.Fields.List {
+		if len(field.Names) > 0 {
+			continue // not an embedded field
+		}
+
+		id := embeddedTypeName(field.Type)
+		if id == nil || id.IsExported() {
+			continue
+		}
+
+		v, ok := info.Defs[id].(*types.Var)
+		if !ok {
+			continue
+		}
+
+		promoted := promotedExportedMethods(methods, structType, v)
+		if len(promoted) == 0 {
+			continue
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "naming",
+			Confidence: 0.8,
+			Node:       field,
+			Failure:    fmt.Sprintf("exported struct %s embeds unexported type %s thus making its methods %s part of the public API", spec.Name.Name, id.Name, strings.Join(promoted, ", ")),
+		})
+	}
+
+	return nil
+}
+
+// embeddedTypeName returns the identifier of the type name of an embedded field
+func embeddedTypeName(expr ast.Expr) *ast.Ident {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return e
+	case *ast.StarExpr:
+		return embeddedTypeName(e.X)
+	case *ast.SelectorExpr:
+		return e.Sel
+	case *ast.IndexExpr:
+		return embeddedTypeName(e.X)
+	case *ast.IndexListExpr:
+		return embeddedTypeName(e.X)
+	}
+
+	return nil
+}
+
+// promotedExportedMethods returns the sorted names of the exported methods
+// promoted through the given embedded field.
+func promotedExportedMethods(methods *types.MethodSet, st *types.Struct, field *types.Var) []string {
+	fieldIdx := -1
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i) == field {
+			fieldIdx = i
+			break
+		}
+	}
+
+	var result []string
+	for i := 0; i < methods.Len(); i++ {
+		sel := methods.At(i)
+		idx := sel.Index()
+		if len(idx) < 2 || idx[0] != fieldIdx || !sel.Obj().Exported() {
+			continue
+		}
+		result = append(result, sel.Obj().Name())
+	}
+
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,185 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// IncomparableTypeCompareRule spots comparisons of interface values holding values of incomparable types.
+type IncomparableTypeCompareRule struct{}
+
+// Apply applies the rule to given file.
+func (*IncomparableTypeCompareRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintIncomparableTypeCompare{file: file, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*IncomparableTypeCompareRule) Name() string {
+	return "incomparable-type-compare"
+}
+
+type lintIncomparableTypeCompare struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintIncomparableTypeCompare) Visit(node ast.Node) ast.Visitor {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	// holders maps interface variables to the concrete type of the value they hold
+	holders := map[types.Object]types.Type{}
+	assign := func(lhs ast.Expr, rhs ast.Expr) {
+		id, ok := lhs.(*ast.Ident)
+		if !ok {
+			return
+		}
+		obj := info.ObjectOf(id)
+		if obj == nil || !isInterface(obj.Type()) {
+			return
+		}
+		if t := w.heldType(rhs, holders); t != nil {
+			holders[obj] = t
+			return
+		}
+		delete(holders, obj)
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				assign(lhs, n.Rhs[i])
+			}
+		case *ast.ValueSpec:
+			if len(n.Names) != len(n.Values) {
+				return true
+			}
+			for i, name := range n.Names {
+				assign(name, n.Values[i])
+			}
+		case *ast.BinaryExpr:
+			if n.Op != token.EQL && n.Op != token.NEQ || isIdent(n.X, "nil") || isIdent(n.Y, "nil") {
+				return true
+			}
+			if !isInterface(w.file.Pkg.TypeOf(n.X)) && !isInterface(w.file.Pkg.TypeOf(n.Y)) {
+				return true
+			}
+
+			tx, ty := w.heldType(n.X, holders), w.heldType(n.Y, holders)
+			for _, operand := range []struct {
+				expr        ast.Expr
+				held, other types.Type
+			}{{n.X, tx, ty}, {n.Y, ty, tx}} {
+				if operand.held == nil || types.Comparable(operand.held) {
+					continue
+				}
+				if operand.other != nil && !types.Identical(operand.held, operand.other) {
+					continue // values of different dynamic types are not equal
+				}
+				w.report(n, fmt.Sprintf("comparing %s, that holds a value of the incomparable type %s, will panic at runtime", gofmt(operand.expr), w.typeString(operand.held)))
+				break
+			}
+		case *ast.IndexExpr:
+			t := w.file.Pkg.TypeOf(n.X)
+			if t == nil {
+				return true
+			}
+			m, ok := t.Underlying().(*types.Map)
+			if !ok || !isInterface(m.Key()) {
+				return true
+			}
+			if t := w.heldType(n.Index, holders); t != nil && !types.Comparable(t) {
+				w.report(n, fmt.Sprintf("using %s, that holds a value of the incomparable type %s, as a map key will panic at runtime", gofmt(n.Index), w.typeString(t)))
+			}
+		}
+		return true
+	})
+
+	return w
+}
+
+// heldType returns the concrete type of the value that the given expression holds, if known
+func (w lintIncomparableTypeCompare) heldType(expr ast.Expr, holders map[types.Object]types.Type) types.Type {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.X
+	}
+
+	t := w.file.Pkg.TypeOf(expr)
+	if t == nil || isIdent(expr, "nil") {
+		return nil
+	}
+
+	if !isInterface(t) {
+		return t
+	}
+
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return holders[w.file.Pkg.TypesInfo().Uses[e]]
+	case *ast.CallExpr:
+		// conversion to an interface type like any(s)
+		if len(e.Args) == 1 && w.file.Pkg.TypesInfo().Types[e.Fun].IsType() {
+			return w.heldType(e.Args[0], holders)
+		}
+	}
+
+	return nil
+}
+
+func (w lintIncomparableTypeCompare) typeString(t types.Type) string {
+	return types.TypeString(t, types.RelativeTo(w.file.Pkg.TypesPkg()))
+}
+
+func (w lintIncomparableTypeCompare) report(node ast.Node, msg string) {
+	w.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 1,
+		Node:       node,
+		Failure:    msg,
+	})
+}
+
+func isInterface(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+	_, ok := t.Underlying().(*types.Interface)
+	return ok
+}
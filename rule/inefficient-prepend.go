@@ -0,0 +1,62 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// InefficientPrependRule spots slices prepended to, by copying them with append, within loops.
+type InefficientPrependRule struct{}
+
+// Apply applies the rule to given file.
+func (*InefficientPrependRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+
+	inspectLoops(file.AST, func(n ast.Node, inLoop bool) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !inLoop || !isPrepend(info, call) {
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "performance",
+			Confidence: 0.8,
+			Node:       call,
+			Failure:    fmt.Sprintf("prepending to %s with append copies the whole slice at each iteration of the loop (quadratic if the slice grows); append the elements and reverse the slice once, or use another data structure", gofmt(call.Args[1])),
+		})
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*InefficientPrependRule) Name() string {
+	return "inefficient-prepend"
+}
+
+// isPrepend returns true if the call prepends elements to a slice by copying it (i.e. append([]T{x}, s...))
+func isPrepend(info *types.Info, call *ast.CallExpr) bool {
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok || id.Name != "append" || !call.Ellipsis.IsValid() || len(call.Args) != 2 {
+		return false
+	}
+	if info != nil {
+		if _, isBuiltin := info.Uses[id].(*types.Builtin); !isBuiltin {
+			return false // shadowed append
+		}
+	}
+
+	lit, ok := unparen(call.Args[0]).(*ast.CompositeLit)
+	if !ok || len(lit.Elts) == 0 {
+		return false // append([]T{}, s...) copies s
+	}
+	_, isSlice := lit.Type.(*ast.ArrayType)
+	return isSlice
+}
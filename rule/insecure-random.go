@@ -0,0 +1,191 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const defaultInsecureRandomSinkPattern = `(?i)(token|password|passwd|salt|secret|nonce|key)`
+
+// InsecureRandomRule spots values generated with math/rand that feed security-sensitive sinks.
+type InsecureRandomRule struct {
+	sinks []*regexp.Regexp
+	sync.Mutex
+}
+
+func (r *InsecureRandomRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.sinks != nil {
+		return
+	}
+
+	patterns := []string{defaultInsecureRandomSinkPattern}
+	if len(arguments) > 0 {
+		patterns = make([]string, 0, len(arguments))
+		for _, arg := range arguments {
+			pattern, ok := arg.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+			}
+			patterns = append(patterns, pattern)
+		}
+	}
+
+	r.sinks = make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting %q to be a valid regular expression, got: %v", r.Name(), pattern, err))
+		}
+		r.sinks = append(r.sinks, re)
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *InsecureRandomRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := &lintInsecureRandom{file: file, sinks: r.sinks, onFailure: onFailure}
+	for _, decl := range file.AST.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Body != nil {
+			w.checkFunc(fd)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*InsecureRandomRule) Name() string {
+	return "insecure-random"
+}
+
+type lintInsecureRandom struct {
+	file      *lint.File
+	sinks     []*regexp.Regexp
+	onFailure func(lint.Failure)
+}
+
+func (w *lintInsecureRandom) isSink(name string) bool {
+	for _, re := range w.sinks {
+		if re.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func (w *lintInsecureRandom) checkFunc(fd *ast.FuncDecl) {
+	if w.isSink(fd.Name.Name) {
+		for _, call := range w.randCalls(fd.Body) {
+			w.report(call, fd.Name.Name)
+		}
+		return
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	// randomVars maps variables to the math/rand calls of the values assigned to them
+	randomVars := map[types.Object][]*ast.CallExpr{}
+	assign := func(id *ast.Ident, value ast.Expr) {
+		calls := w.randCalls(value)
+		if len(calls) == 0 {
+			return
+		}
+
+		if w.isSink(id.Name) {
+			for _, call := range calls {
+				w.report(call, id.Name)
+			}
+			return
+		}
+
+		obj := info.ObjectOf(id)
+		if obj != nil {
+			randomVars[obj] = append(randomVars[obj], calls...)
+		}
+	}
+
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					assign(id, n.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range n.Values {
+				if i < len(n.Names) {
+					assign(n.Names[i], value)
+				}
+			}
+		case *ast.CallExpr:
+			sink := assigneeName(n.Fun)
+			if sink == "" || !w.isSink(sink) {
+				return true
+			}
+			for _, arg := range n.Args {
+				for _, call := range w.randCalls(arg) {
+					w.report(call, sink)
+				}
+				if id, ok := arg.(*ast.Ident); ok {
+					for _, call := range randomVars[info.Uses[id]] {
+						w.report(call, sink)
+					}
+				}
+			}
+		}
+		return true
+	})
+}
+
+// randCalls returns the calls to math/rand functions in the given node
+func (w *lintInsecureRandom) randCalls(node ast.Node) []*ast.CallExpr {
+	var result []*ast.CallExpr
+	ast.Inspect(node, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+
+		fn, ok := w.file.Pkg.TypesInfo().Uses[sel.Sel].(*types.Func)
+		if ok && fn.Pkg() != nil && strings.HasPrefix(fn.Pkg().Path(), "math/rand") {
+			result = append(result, call)
+		}
+		return true
+	})
+
+	return result
+}
+
+func (w *lintInsecureRandom) report(call *ast.CallExpr, sink string) {
+	w.onFailure(lint.Failure{
+		Category:   "security",
+		Confidence: 0.8,
+		Node:       call,
+		Failure:    fmt.Sprintf("%s from math/rand is used for %s, use crypto/rand for security-sensitive values", gofmt(call.Fun), sink),
+	})
+}
@@ -0,0 +1,84 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// IntegerDivisionRule spots integer divisions whose result is converted to a floating-point type.
+type IntegerDivisionRule struct{}
+
+// Apply applies the rule to given file.
+func (*IntegerDivisionRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+
+		target, ok := info.Types[call.Fun]
+		if !ok || !target.IsType() || !isBasicOfKind(target.Type, types.IsFloat) {
+			return true // not a conversion to a floating-point type
+		}
+
+		division, ok := unparen(call.Args[0]).(*ast.BinaryExpr)
+		if !ok || division.Op != token.QUO {
+			return true
+		}
+
+		result, ok := info.Types[division]
+		if !ok || result.Value != nil || !isBasicOfKind(result.Type, types.IsInteger) {
+			return true // not an integer division or a constant one
+		}
+
+		conversion := gofmt(call.Fun)
+		failures = append(failures, lint.Failure{
+			Category:   "logic",
+			Confidence: 0.6,
+			Node:       division,
+			Failure: fmt.Sprintf("integer division %s truncates its result before the conversion to %s, consider converting the operands: %s(%s) / %s(%s)",
+				gofmt(division), conversion, conversion, gofmt(unparen(division.X)), conversion, gofmt(unparen(division.Y))),
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*IntegerDivisionRule) Name() string {
+	return "integer-division"
+}
+
+// isBasicOfKind returns true if the underlying type of t is a basic type with the given info flag
+func isBasicOfKind(t types.Type, kind types.BasicInfo) bool {
+	if t == nil {
+		return false
+	}
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&kind != 0
+}
+
+// unparen returns the expression with any enclosing parentheses removed
+func unparen(expr ast.Expr) ast.Expr {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			return expr
+		}
+		expr = paren.X
+	}
+}
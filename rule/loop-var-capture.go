@@ -0,0 +1,65 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// LoopVarCaptureRule spots goroutines and deferred closures, within loops, referencing loop variables.
+type LoopVarCaptureRule struct {
+	perIterationLoopVars bool
+	configured           bool
+	sync.Mutex
+}
+
+func (r *LoopVarCaptureRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	r.perIterationLoopVars = isPerIterationLoopVarsVersion(r.Name(), arguments)
+}
+
+// Apply applies the rule to given file.
+func (r *LoopVarCaptureRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	inspectLoopClosures(info, file.AST, r.perIterationLoopVars, func(stmt ast.Stmt, captured []string) {
+		vars := strings.Join(captured, ", ")
+		failure := lint.Failure{
+			Category:   "logic",
+			Confidence: 1,
+			Node:       stmt,
+			Failure:    fmt.Sprintf("deferred closure captures the loop variable(s) %s, all the deferred calls will see their last value; pass them as arguments of the closure", vars),
+		}
+		if _, ok := stmt.(*ast.GoStmt); ok {
+			// the goroutine might complete before the next iteration (e.g. if the loop waits for it)
+			failure.Confidence = 0.8
+			failure.Failure = fmt.Sprintf("goroutine captures the loop variable(s) %s, it may see the values of later iterations; pass them as arguments of the closure", vars)
+		}
+		failures = append(failures, failure)
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*LoopVarCaptureRule) Name() string {
+	return "loop-var-capture"
+}
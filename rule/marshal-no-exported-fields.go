@@ -0,0 +1,129 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// MarshalNoExportedFieldsRule spots JSON marshaling of structs without exported fields.
+type MarshalNoExportedFieldsRule struct{}
+
+// Apply applies the rule to given file.
+func (*MarshalNoExportedFieldsRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintMarshalNoExportedFields{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*MarshalNoExportedFieldsRule) Name() string {
+	return "marshal-no-exported-fields"
+}
+
+type lintMarshalNoExportedFields struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintMarshalNoExportedFields) Visit(node ast.Node) ast.Visitor {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) == 0 {
+		return w
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return w
+	}
+
+	fn, ok := w.file.Pkg.TypesInfo().Uses[sel.Sel].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "encoding/json" {
+		return w
+	}
+
+	switch fn.Name() {
+	case "Marshal", "MarshalIndent", "Encode":
+	default:
+		return w
+	}
+
+	arg := call.Args[0]
+	typ := w.file.Pkg.TypeOf(arg)
+	if typ == nil || !marshalsToEmptyObject(typ) {
+		return w
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 0.9,
+		Node:       call,
+		Failure:    fmt.Sprintf("%s has no exported fields, marshaling it to JSON will produce an empty object", gofmt(arg)),
+	})
+
+	return w
+}
+
+// marshalsToEmptyObject returns true if the given type is a struct with fields but
+// without exported (or promoted) ones and without custom marshaling methods.
+func marshalsToEmptyObject(typ types.Type) bool {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok || st.NumFields() == 0 {
+		return false
+	}
+
+	return !hasCustomMarshaling(typ) && !hasExportedFields(st)
+}
+
+func hasCustomMarshaling(typ types.Type) bool {
+	methods := types.NewMethodSet(types.NewPointer(typ))
+	for _, name := range []string{"MarshalJSON", "MarshalText"} {
+		if methods.Lookup(nil, name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasExportedFields(st *types.Struct) bool {
+	for i := 0; i < st.NumFields(); i++ {
+		field := st.Field(i)
+		if field.Exported() {
+			return true
+		}
+
+		if !field.Embedded() {
+			continue
+		}
+
+		// fields of embedded structs are promoted
+		typ := field.Type()
+		if ptr, ok := typ.(*types.Pointer); ok {
+			typ = ptr.Elem()
+		}
+		if hasCustomMarshaling(typ) {
+			return true
+		}
+		if embedded, ok := typ.Underlying().(*types.Struct); ok && hasExportedFields(embedded) {
+			return true
+		}
+	}
+
+	return false
+}
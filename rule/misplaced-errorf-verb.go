@@ -0,0 +1,126 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// MisplacedErrorfVerbRule spots %w verbs in format strings of printf-like functions other than fmt.Errorf.
+type MisplacedErrorfVerbRule struct{}
+
+// Apply applies the rule to given file.
+func (*MisplacedErrorfVerbRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintMisplacedErrorfVerb{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*MisplacedErrorfVerbRule) Name() string {
+	return "misplaced-errorf-verb"
+}
+
+type lintMisplacedErrorfVerb struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintMisplacedErrorfVerb) Visit(node ast.Node) ast.Visitor {
+	call, ok := node.(*ast.CallExpr)
+	if !ok {
+		return w
+	}
+
+	var fnName *ast.Ident
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		fnName = fn
+	case *ast.SelectorExpr:
+		fnName = fn.Sel
+	default:
+		return w
+	}
+
+	if !w.isPrintfLike(fnName) {
+		return w
+	}
+
+	// the format is the first string literal among the first two arguments (e.g. fmt.Printf and fmt.Fprintf)
+	for i, arg := range call.Args {
+		if i > 1 {
+			break
+		}
+
+		lit, ok := arg.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil || !hasWrapVerb(format) {
+			return w
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "errors",
+			Confidence: 0.8,
+			Node:       call,
+			Failure:    fmt.Sprintf("the %%w verb is only supported by fmt.Errorf, %s will print %%!w(...) instead; use %%v", gofmt(call.Fun)),
+		})
+		return w
+	}
+
+	return w
+}
+
+// printfLikePackages are the packages whose functions and methods suffixed with f are printf-like
+var printfLikePackages = map[string]bool{"fmt": true, "log": true, "testing": true}
+
+// isPrintfLike returns true if the given identifier refers to a printf-like function
+// (or method) of the standard library that does not support the %w verb.
+// User-defined wrappers are not considered because their format might end up in a fmt.Errorf.
+func (w lintMisplacedErrorfVerb) isPrintfLike(id *ast.Ident) bool {
+	fn, ok := w.file.Pkg.TypesInfo().Uses[id].(*types.Func)
+	if !ok || fn.Pkg() == nil || !printfLikePackages[fn.Pkg().Path()] {
+		return false
+	}
+
+	isFmtErrorf := fn.Pkg().Path() == "fmt" && fn.Name() == "Errorf"
+	return strings.HasSuffix(fn.Name(), "f") && !isFmtErrorf
+}
+
+// hasWrapVerb returns true if the given format string contains a %w verb
+func hasWrapVerb(format string) bool {
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+
+		// skip flags, argument indexes, width and precision
+		i++
+		for i < len(format) && strings.IndexByte("+-# 0123456789.*[]", format[i]) >= 0 {
+			i++
+		}
+
+		if i < len(format) && format[i] == 'w' {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,165 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// testFailureMethods are the methods of testing.T reporting a failure
+var testFailureMethods = map[string]bool{
+	"Error":  true,
+	"Errorf": true,
+	"Fatal":  true,
+	"Fatalf": true,
+}
+
+// MissingTestHelperRule spots test helpers reporting failures without calling t.Helper().
+type MissingTestHelperRule struct {
+	supportFiles []*lint.FileFilter
+	configured   bool
+	sync.Mutex
+}
+
+func (r *MissingTestHelperRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	for _, arg := range arguments {
+		pattern, ok := arg.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+		}
+		filter, err := lint.ParseFileFilter(pattern)
+		if err != nil {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting %q to be a valid file pattern, got: %v", r.Name(), pattern, err))
+		}
+		r.supportFiles = append(r.supportFiles, filter)
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *MissingTestHelperRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if !file.IsTest() && !r.isSupportFile(file.Name) {
+		return nil
+	}
+
+	file.Pkg.TypeCheck()
+
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || isTestEntryPoint(fn) {
+			continue
+		}
+
+		t := testingTParam(file, fn)
+		if t == "" || callsTestHelper(fn.Body, t) {
+			continue
+		}
+
+		failure := firstTestFailure(fn.Body, t)
+		if failure == nil {
+			continue
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "testing",
+			Confidence: 0.8,
+			Node:       fn.Name,
+			Failure:    fmt.Sprintf("test helper %s reports failures with %s but does not call %s.Helper(), failures will be reported at the wrong line", fn.Name.Name, gofmt(failure.Fun), t),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*MissingTestHelperRule) Name() string {
+	return "missing-test-helper"
+}
+
+func (r *MissingTestHelperRule) isSupportFile(name string) bool {
+	for _, filter := range r.supportFiles {
+		if filter.MatchFileName(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTestEntryPoint returns true if the given function is run by the testing package (Test, Benchmark, Fuzz)
+func isTestEntryPoint(fn *ast.FuncDecl) bool {
+	if fn.Recv != nil {
+		return false
+	}
+	for _, prefix := range []string{"Test", "Benchmark", "Fuzz"} {
+		if strings.HasPrefix(fn.Name.Name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// testingTParam returns the name of the first parameter of the function if it is a *testing.T or a testing.TB
+func testingTParam(file *lint.File, fn *ast.FuncDecl) string {
+	params := fn.Type.Params.List
+	if len(params) == 0 || len(params[0].Names) == 0 || isBlank(params[0].Names[0]) {
+		return ""
+	}
+
+	typ := file.Pkg.TypeOf(params[0].Type)
+	if typ == nil {
+		return ""
+	}
+
+	switch typ.String() {
+	case "*testing.T", "testing.TB":
+		return params[0].Names[0].Name
+	}
+	return ""
+}
+
+func callsTestHelper(body *ast.BlockStmt, t string) bool {
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		if call, ok := n.(*ast.CallExpr); ok && isPkgDot(call.Fun, t, "Helper") {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// firstTestFailure returns the first call reporting a test failure in the given body, if any
+func firstTestFailure(body *ast.BlockStmt, t string) *ast.CallExpr {
+	var result *ast.CallExpr
+	ast.Inspect(body, func(n ast.Node) bool {
+		if result != nil {
+			return false
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false // e.g. t.Run(name, func(t *testing.T) {...})
+		}
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if ok && isIdent(sel.X, t) && testFailureMethods[sel.Sel.Name] {
+			result = call
+		}
+		return true
+	})
+	return result
+}
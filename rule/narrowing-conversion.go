@@ -0,0 +1,238 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const defaultNarrowingConversionConfidence = 0.8
+
+// NarrowingConversionRule spots conversions to narrower numeric types that may lose data.
+type NarrowingConversionRule struct {
+	confidence float64
+	sync.Mutex
+}
+
+func (r *NarrowingConversionRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.confidence != 0 {
+		return
+	}
+
+	if len(arguments) < 1 {
+		r.confidence = defaultNarrowingConversionConfidence
+		return
+	}
+
+	confidence, ok := arguments[0].(float64)
+	if !ok || confidence <= 0 || confidence > 1 {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a confidence in ]0, 1]", arguments[0], r.Name()))
+	}
+	r.confidence = confidence
+}
+
+// Apply applies the rule to given file.
+func (r *NarrowingConversionRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	check := func(root ast.Node, guarded map[string]token.Pos) {
+		ast.Inspect(root, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 {
+				return true
+			}
+
+			from, to, ok := narrowingConversion(info, call)
+			if !ok || isMaskedToFit(info, call.Args[0], to) {
+				return true
+			}
+
+			if pos, ok := guarded[gofmt(call.Args[0])]; ok && pos < call.Pos() {
+				return true // a bounds check precedes the conversion
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "logic",
+				Confidence: r.confidence,
+				Node:       call,
+				Failure:    fmt.Sprintf("conversion from %s to %s may lose data, check the bounds of the value before converting it", from, to),
+			})
+
+			return true
+		})
+	}
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			check(decl, nil)
+			continue
+		}
+		if fn.Body != nil {
+			check(fn.Body, comparedExpressions(fn.Body))
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NarrowingConversionRule) Name() string {
+	return "narrowing-conversion"
+}
+
+// narrowingConversion returns the source and target types if the call converts
+// a non constant integer (resp. float) to a narrower integer (resp. float) type
+func narrowingConversion(info *types.Info, call *ast.CallExpr) (from, to *types.Basic, ok bool) {
+	target, found := info.Types[call.Fun]
+	if !found || !target.IsType() {
+		return nil, nil, false
+	}
+
+	arg, found := info.Types[call.Args[0]]
+	if !found || arg.Value != nil {
+		return nil, nil, false // not a conversion or constant argument (checked by the compiler)
+	}
+
+	to, ok = target.Type.Underlying().(*types.Basic)
+	if !ok {
+		return nil, nil, false
+	}
+	from, ok = arg.Type.Underlying().(*types.Basic)
+	if !ok {
+		return nil, nil, false
+	}
+
+	switch {
+	case from.Info()&types.IsInteger != 0 && to.Info()&types.IsInteger != 0:
+		return from, to, basicSize(to) < basicSize(from)
+	case from.Info()&types.IsFloat != 0 && to.Info()&types.IsFloat != 0:
+		return from, to, basicSize(to) < basicSize(from)
+	}
+
+	return nil, nil, false
+}
+
+// basicSize returns the size in bytes of numeric basic types, assuming a 64 bits architecture
+func basicSize(t *types.Basic) int64 {
+	return types.SizesFor("gc", "amd64").Sizeof(t)
+}
+
+// isMaskedToFit returns true if the expression is a bitwise and (or a remainder) with a constant fitting in the given type
+func isMaskedToFit(info *types.Info, expr ast.Expr, to *types.Basic) bool {
+	for {
+		paren, ok := expr.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		expr = paren.X
+	}
+
+	binary, ok := expr.(*ast.BinaryExpr)
+	if !ok || (binary.Op != token.AND && binary.Op != token.REM) {
+		return false
+	}
+
+	if binary.Op == token.REM {
+		// x % n lies in ]-n, n[
+		tv, ok := info.Types[binary.Y]
+		return ok && tv.Value != nil && fitsIn(constant.BinaryOp(tv.Value, token.SUB, constant.MakeInt64(1)), to)
+	}
+
+	for _, operand := range []ast.Expr{binary.X, binary.Y} {
+		if tv, ok := info.Types[operand]; ok && tv.Value != nil && fitsIn(tv.Value, to) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// fitsIn returns true if the integer constant can be represented by the given integer type
+func fitsIn(value constant.Value, t *types.Basic) bool {
+	value = constant.ToInt(value)
+	if value.Kind() != constant.Int || t.Info()&types.IsInteger == 0 {
+		return false
+	}
+
+	bits := uint(basicSize(t) * 8)
+	lowest, highest := constant.MakeInt64(0), constant.Shift(constant.MakeInt64(1), token.SHL, bits)
+	if t.Info()&types.IsUnsigned == 0 {
+		highest = constant.Shift(constant.MakeInt64(1), token.SHL, bits-1)
+		lowest = constant.UnaryOp(token.SUB, highest, 0)
+	}
+
+	return constant.Compare(value, token.GEQ, lowest) && constant.Compare(value, token.LSS, highest)
+}
+
+// comparedExpressions returns the position of the first comparison of each expression compared within the given node.
+// Round-trip comparisons, as in x != int64(int32(x)), are considered comparisons of x.
+func comparedExpressions(node ast.Node) map[string]token.Pos {
+	result := map[string]token.Pos{}
+	ast.Inspect(node, func(n ast.Node) bool {
+		binary, ok := n.(*ast.BinaryExpr)
+		if !ok {
+			return true
+		}
+
+		switch binary.Op {
+		case token.LSS, token.LEQ, token.GTR, token.GEQ:
+			for _, operand := range []ast.Expr{binary.X, binary.Y} {
+				key := gofmt(operand)
+				if _, found := result[key]; !found {
+					result[key] = binary.Pos()
+				}
+			}
+		case token.EQL, token.NEQ:
+			key, ok := roundTripOperand(binary)
+			if !ok {
+				break
+			}
+			if _, found := result[key]; !found {
+				result[key] = binary.Pos()
+			}
+		}
+
+		return true
+	})
+
+	return result
+}
+
+// roundTripOperand returns the source of x if the given comparison compares x with a double conversion of x
+// (e.g. x != int64(int32(x))), a common idiom to check that x fits in a narrower type.
+func roundTripOperand(binary *ast.BinaryExpr) (string, bool) {
+	for _, operands := range [][2]ast.Expr{{binary.X, binary.Y}, {binary.Y, binary.X}} {
+		outer, ok := unparen(operands[1]).(*ast.CallExpr)
+		if !ok || len(outer.Args) != 1 {
+			continue
+		}
+		inner, ok := unparen(outer.Args[0]).(*ast.CallExpr)
+		if !ok || len(inner.Args) != 1 {
+			continue
+		}
+
+		key := gofmt(unparen(operands[0]))
+		if gofmt(unparen(inner.Args[0])) == key {
+			return key, true
+		}
+	}
+
+	return "", false
+}
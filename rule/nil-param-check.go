@@ -0,0 +1,205 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NilParamCheckRule spots exported functions dereferencing pointer parameters without checking them for nil.
+type NilParamCheckRule struct {
+	ignoredTypes map[string]bool
+	sync.Mutex
+}
+
+func (r *NilParamCheckRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.ignoredTypes != nil {
+		return
+	}
+	r.ignoredTypes = map[string]bool{}
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		switch k {
+		case "ignoreTypes":
+			types, ok := v.([]any)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting ignoreTypes to be a list of type names, got %T", r.Name(), v))
+			}
+			for _, t := range types {
+				name, ok := t.(string)
+				if !ok {
+					panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting ignoreTypes to be a list of type names, got %T in the list", r.Name(), t))
+				}
+				r.ignoredTypes[name] = true
+			}
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NilParamCheckRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if file.IsTest() {
+		return nil
+	}
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || !fn.Name.IsExported() {
+			continue
+		}
+		if fn.Recv != nil && !isExportedReceiver(fn) {
+			continue
+		}
+
+		for _, field := range fn.Type.Params.List {
+			for _, name := range field.Names {
+				param, ok := info.Defs[name].(*types.Var)
+				if !ok || isBlank(name) || !r.mustBeChecked(param.Type()) {
+					continue
+				}
+
+				deref := firstUncheckedDereference(info, fn.Body, param)
+				if deref == nil {
+					continue
+				}
+
+				failures = append(failures, lint.Failure{
+					Category:   "bad practice",
+					Confidence: 0.8,
+					Node:       deref,
+					Failure:    fmt.Sprintf("parameter %s of the exported function %s is dereferenced without being checked for nil, validate it (e.g. if %s == nil { return ... })", name.Name, fn.Name.Name, name.Name),
+				})
+			}
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NilParamCheckRule) Name() string {
+	return "nil-param-check"
+}
+
+// mustBeChecked returns true if parameters of the given type must be checked for nil
+func (r *NilParamCheckRule) mustBeChecked(t types.Type) bool {
+	ptr, ok := t.(*types.Pointer)
+	if !ok {
+		return false
+	}
+
+	name := types.TypeString(ptr.Elem(), func(p *types.Package) string { return p.Name() })
+	return !r.ignoredTypes[name]
+}
+
+// isExportedReceiver returns true if the receiver type of the method is exported
+func isExportedReceiver(fn *ast.FuncDecl) bool {
+	t := fn.Recv.List[0].Type
+	if star, ok := t.(*ast.StarExpr); ok {
+		t = star.X
+	}
+	switch tt := t.(type) {
+	case *ast.IndexExpr:
+		t = tt.X
+	case *ast.IndexListExpr:
+		t = tt.X
+	}
+
+	id, ok := t.(*ast.Ident)
+	return ok && id.IsExported()
+}
+
+// firstUncheckedDereference returns the dereference of the parameter if it is its first use in the function body.
+// Other first uses (nil checks, but also passing the parameter to a function, assigning it...) make the function safe or
+// move the responsibility of checking the parameter elsewhere.
+func firstUncheckedDereference(info *types.Info, body *ast.BlockStmt, param *types.Var) ast.Node {
+	var result ast.Node
+	done := false
+	var parents []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if done {
+			return false
+		}
+		if n == nil {
+			parents = parents[:len(parents)-1]
+			return true
+		}
+		if _, ok := n.(*ast.FuncLit); ok {
+			return false // closures might not be called
+		}
+
+		id, ok := n.(*ast.Ident)
+		if !ok || info.Uses[id] != param {
+			parents = append(parents, n)
+			return true
+		}
+
+		done = true
+		var use ast.Node = id
+		for i := len(parents) - 1; i >= 0; i-- {
+			if _, ok := parents[i].(*ast.ParenExpr); ok {
+				use = parents[i]
+				continue
+			}
+			if isDereference(info, parents[i], use) {
+				result = parents[i]
+			}
+			break
+		}
+		return false
+	})
+
+	return result
+}
+
+// isDereference returns true if the given node dereferences the pointer used as its direct child
+func isDereference(info *types.Info, node, child ast.Node) bool {
+	switch n := node.(type) {
+	case *ast.StarExpr:
+		return true
+	case *ast.IndexExpr:
+		return n.X == child // pointer to array
+	case *ast.SelectorExpr:
+		sel, ok := info.Selections[n]
+		if !ok {
+			return false
+		}
+		if sel.Kind() == types.FieldVal {
+			return true
+		}
+		// methods with pointer receivers are called with the pointer, they might handle nil
+		sig, ok := sel.Obj().Type().(*types.Signature)
+		if !ok || sig.Recv() == nil {
+			return false
+		}
+		_, isPtr := sig.Recv().Type().(*types.Pointer)
+		return !isPtr
+	}
+
+	return false
+}
@@ -0,0 +1,85 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NoContextInStructRule spots struct fields of type context.Context.
+type NoContextInStructRule struct {
+	allowedTypes map[string]bool
+	sync.Mutex
+}
+
+func (r *NoContextInStructRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.allowedTypes != nil {
+		return
+	}
+
+	r.allowedTypes = make(map[string]bool, len(arguments))
+	for _, arg := range arguments {
+		typeName, ok := arg.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+		}
+		r.allowedTypes[typeName] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NoContextInStructRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		ts, ok := n.(*ast.TypeSpec)
+		if !ok || r.allowedTypes[ts.Name.Name] {
+			return true
+		}
+
+		// also check the structs nested in the declared type
+		ast.Inspect(ts.Type, func(n ast.Node) bool {
+			st, ok := n.(*ast.StructType)
+			if !ok {
+				return true
+			}
+
+			for _, field := range st.Fields.List {
+				if !isNamedType(file.Pkg.TypeOf(field.Type), "context", "Context") {
+					continue
+				}
+
+				name := "embedded context.Context"
+				if len(field.Names) > 0 {
+					name = "field " + field.Names[0].Name
+				}
+				failures = append(failures, lint.Failure{
+					Category:   "bad practice",
+					Confidence: 0.8,
+					Node:       field,
+					Failure:    fmt.Sprintf("%s of %s stores a context.Context, pass the context to each method call instead", name, ts.Name.Name),
+				})
+			}
+
+			return true
+		})
+
+		return false
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoContextInStructRule) Name() string {
+	return "no-context-in-struct"
+}
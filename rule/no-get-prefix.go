@@ -0,0 +1,134 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+	"sync"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NoGetPrefixRule spots getters named GetXxx instead of Xxx.
+type NoGetPrefixRule struct {
+	configured            bool
+	allowInterfaceMethods bool
+	allowedNames          map[string]bool
+	sync.Mutex
+}
+
+func (r *NoGetPrefixRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+	r.allowedNames = map[string]bool{}
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+	}
+
+	for k, v := range args {
+		switch k {
+		case "allowInterfaceMethods":
+			r.allowInterfaceMethods, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a boolean, got %T", v, k, r.Name(), v))
+			}
+		case "allowedNames":
+			names, ok := v.([]any)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a list of strings, got %T", v, k, r.Name(), v))
+			}
+			for _, n := range names {
+				name, ok := n.(string)
+				if !ok {
+					panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a string, got %T", n, k, r.Name(), n))
+				}
+				r.allowedNames[name] = true
+			}
+		default:
+			panic(fmt.Sprintf("Unknown argument '%s' for rule '%s'", k, r.Name()))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NoGetPrefixRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	var interfaces []*types.Interface
+	if r.allowInterfaceMethods {
+		interfaces = exportedInterfaces(file.Pkg.TypesPkg())
+	}
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 || r.allowedNames[fn.Name.Name] {
+			continue
+		}
+
+		name := getterName(fn.Name.Name)
+		if name == "" {
+			continue
+		}
+
+		if fn.Type.Params.NumFields() != 0 || fn.Type.Results.NumFields() != 1 {
+			continue // not a getter
+		}
+
+		recv := file.Pkg.TypeOf(fn.Recv.List[0].Type)
+		if recv != nil {
+			if obj, _, _ := types.LookupFieldOrMethod(recv, true, file.Pkg.TypesPkg(), name); obj != nil {
+				continue // the name without prefix is already taken
+			}
+		}
+
+		if r.allowInterfaceMethods && implementsInterfaceMethod(recv, fn.Name.Name, interfaces) {
+			continue
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "naming",
+			Confidence: 0.8,
+			Node:       fn.Name,
+			Failure:    fmt.Sprintf("getter %s should not have a Get prefix, consider renaming it %s", fn.Name.Name, name),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoGetPrefixRule) Name() string {
+	return "no-get-prefix"
+}
+
+// getterName returns Xxx for a method name of the form GetXxx, or an empty string otherwise
+func getterName(method string) string {
+	name := strings.TrimPrefix(method, "Get")
+	if name == method || name == "" {
+		return ""
+	}
+
+	if r, _ := utf8.DecodeRuneInString(name); !unicode.IsUpper(r) {
+		return "" // e.g. Getaway
+	}
+
+	return name
+}
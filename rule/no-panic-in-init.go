@@ -0,0 +1,112 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// initAbortFunctions maps import paths to the functions of the package aborting the program
+var initAbortFunctions = map[string]map[string]bool{
+	"os":      {"Exit": true},
+	"syscall": {"Exit": true},
+	"log": {
+		"Fatal":   true,
+		"Fatalf":  true,
+		"Fatalln": true,
+		"Panic":   true,
+		"Panicf":  true,
+		"Panicln": true,
+	},
+}
+
+// NoPanicInInitRule spots calls aborting the program (panic, log.Fatal, os.Exit...) in init functions.
+type NoPanicInInitRule struct {
+	allowedPackages map[string]bool
+	sync.Mutex
+}
+
+func (r *NoPanicInInitRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.allowedPackages != nil {
+		return
+	}
+
+	r.allowedPackages = make(map[string]bool, len(arguments))
+	for _, arg := range arguments {
+		pkg, ok := arg.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+		}
+		r.allowedPackages[pkg] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NoPanicInInitRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if r.allowedPackages[file.AST.Name.Name] {
+		return nil
+	}
+
+	var failures []lint.Failure
+
+	// abortFunctions maps the names under which packages are imported in the file to their aborting functions
+	abortFunctions := map[string]map[string]bool{}
+	for path, functions := range initAbortFunctions {
+		if name := importName(file.AST, path); name != "" {
+			abortFunctions[name] = functions
+		}
+	}
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name.Name != "init" || fn.Body == nil {
+			continue
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false // might not be executed at initialization
+			case *ast.CallExpr:
+				if !isInitAbortCall(n, abortFunctions) {
+					return true
+				}
+				failures = append(failures, lint.Failure{
+					Category:   "bad practice",
+					Confidence: 1,
+					Node:       n,
+					Failure:    fmt.Sprintf("calls to %s in init functions crash the program at import time, move the failing initialization to an explicit function returning an error", gofmt(n.Fun)),
+				})
+			}
+			return true
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoPanicInInitRule) Name() string {
+	return "no-panic-in-init"
+}
+
+func isInitAbortCall(call *ast.CallExpr, abortFunctions map[string]map[string]bool) bool {
+	if isIdent(call.Fun, "panic") {
+		return true
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && abortFunctions[pkg.Name][sel.Sel.Name]
+}
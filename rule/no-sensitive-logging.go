@@ -0,0 +1,237 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NoSensitiveLoggingRule spots sensitive data passed to logging and printing functions.
+type NoSensitiveLoggingRule struct {
+	tag            string
+	sensitiveTypes map[string]bool
+	sync.Mutex
+}
+
+const defaultSensitiveTag = "sensitive"
+
+// loggingPackages are the packages whose printing and logging functions (and methods) are checked
+var loggingPackages = map[string]bool{
+	"fmt":      true,
+	"log":      true,
+	"log/slog": true,
+}
+
+// loggingPrefixes are the prefixes of the names of the printing and logging functions
+var loggingPrefixes = []string{"Print", "Sprint", "Fprint", "Append", "Errorf", "Fatal", "Panic", "Debug", "Info", "Warn", "Error", "Log"}
+
+func (r *NoSensitiveLoggingRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.tag != "" {
+		return
+	}
+
+	r.tag = defaultSensitiveTag
+	r.sensitiveTypes = map[string]bool{}
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		switch k {
+		case "tag":
+			tag, ok := v.(string)
+			if !ok || tag == "" {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting tag to be a non-empty string, got %v", r.Name(), v))
+			}
+			r.tag = tag
+		case "types":
+			names, ok := v.([]any)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting types to be a list of type names, got %T", r.Name(), v))
+			}
+			for _, n := range names {
+				name, ok := n.(string)
+				if !ok {
+					panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting types to be a list of type names, got %T in the list", r.Name(), n))
+				}
+				r.sensitiveTypes[name] = true
+			}
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NoSensitiveLoggingRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || !isLoggingCall(info, call) {
+			return true
+		}
+
+		for _, arg := range call.Args {
+			reason := r.sensitivity(info, arg)
+			if reason == "" {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "security",
+				Confidence: 0.8,
+				Node:       call,
+				Failure:    fmt.Sprintf("%s logs %s, %s", gofmt(call.Fun), gofmt(arg), reason),
+			})
+			break // one failure per call
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoSensitiveLoggingRule) Name() string {
+	return "no-sensitive-logging"
+}
+
+// isLoggingCall returns true if the call is a call to a printing or logging function (or method) of the standard library
+func isLoggingCall(info *types.Info, call *ast.CallExpr) bool {
+	var id *ast.Ident
+	switch fun := call.Fun.(type) {
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	case *ast.Ident:
+		id = fun
+	default:
+		return false
+	}
+
+	fn, ok := info.Uses[id].(*types.Func)
+	if !ok || fn.Pkg() == nil || !loggingPackages[fn.Pkg().Path()] {
+		return false
+	}
+	for _, prefix := range loggingPrefixes {
+		if strings.HasPrefix(fn.Name(), prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// sensitivity returns why the given argument holds sensitive data, or an empty string if it does not
+func (r *NoSensitiveLoggingRule) sensitivity(info *types.Info, arg ast.Expr) string {
+	if sel, ok := unparen(arg).(*ast.SelectorExpr); ok {
+		if s, ok := info.Selections[sel]; ok && s.Kind() == types.FieldVal && r.isSensitiveField(s) {
+			return "a field tagged as sensitive; redact it"
+		}
+	}
+
+	field, typeName := r.sensitiveContent(info.TypeOf(arg), map[types.Type]bool{})
+	const advice = "; redact it or implement fmt.Stringer to control its output"
+	switch {
+	case field != "" && typeName != "":
+		return fmt.Sprintf("whose field %s is of the sensitive type %s", field, typeName) + advice
+	case field != "":
+		return fmt.Sprintf("whose field %s is sensitive", field) + advice
+	case typeName != "":
+		return fmt.Sprintf("of the sensitive type %s", typeName) + advice
+	}
+	return ""
+}
+
+// isSensitiveField returns true if the selected field is tagged as sensitive
+func (r *NoSensitiveLoggingRule) isSensitiveField(s *types.Selection) bool {
+	recv := s.Recv()
+	if ptr, ok := recv.Underlying().(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	st, ok := recv.Underlying().(*types.Struct)
+	if !ok {
+		return false
+	}
+
+	for i := 0; i < st.NumFields(); i++ {
+		if st.Field(i) == s.Obj() {
+			return r.isSensitiveTag(st.Tag(i))
+		}
+	}
+	return false
+}
+
+// sensitiveContent returns, for values of the given type holding sensitive data, the path of the sensitive field (e.g. Owner.Password)
+// and the name of the configured sensitive type, if any
+func (r *NoSensitiveLoggingRule) sensitiveContent(t types.Type, visited map[types.Type]bool) (field, typeName string) {
+	if t == nil || visited[t] {
+		return "", ""
+	}
+	visited[t] = true
+
+	if ptr, ok := t.Underlying().(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	if controlsItsOutput(t) {
+		return "", ""
+	}
+
+	name := types.TypeString(t, func(p *types.Package) string { return p.Name() })
+	if r.sensitiveTypes[name] {
+		return "", name
+	}
+
+	st, ok := t.Underlying().(*types.Struct)
+	if !ok {
+		return "", ""
+	}
+	for i := 0; i < st.NumFields(); i++ {
+		if r.isSensitiveTag(st.Tag(i)) {
+			return st.Field(i).Name(), ""
+		}
+		nested, typeName := r.sensitiveContent(st.Field(i).Type(), visited)
+		if nested != "" {
+			return st.Field(i).Name() + "." + nested, typeName
+		}
+		if typeName != "" {
+			return st.Field(i).Name(), typeName
+		}
+	}
+	return "", ""
+}
+
+func (r *NoSensitiveLoggingRule) isSensitiveTag(tag string) bool {
+	return reflect.StructTag(tag).Get(r.tag) == "true"
+}
+
+// controlsItsOutput returns true if values of the type (or pointers to them) decide how they are printed
+func controlsItsOutput(t types.Type) bool {
+	for _, candidate := range []types.Type{t, types.NewPointer(t)} {
+		mset := types.NewMethodSet(candidate)
+		for _, method := range []string{"String", "GoString", "Error", "LogValue", "Format"} {
+			if mset.Lookup(nil, method) != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
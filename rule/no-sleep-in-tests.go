@@ -0,0 +1,108 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"sync"
+	"time"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NoSleepInTestsRule spots calls to time.Sleep in test files.
+type NoSleepInTestsRule struct {
+	maxDuration time.Duration
+	configured  bool
+	sync.Mutex
+}
+
+func (r *NoSleepInTestsRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		switch k {
+		case "maxDuration":
+			value, ok := v.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting maxDuration to be a string, got %T", r.Name(), v))
+			}
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting maxDuration to be a duration (e.g. \"10ms\"), got %q", r.Name(), value))
+			}
+			r.maxDuration = duration
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *NoSleepInTestsRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if !file.IsTest() {
+		return nil
+	}
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return true
+		}
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "time" || fn.Name() != "Sleep" {
+			return true
+		}
+
+		if tv, ok := info.Types[call.Args[0]]; ok && tv.Value != nil {
+			if duration, exact := constant.Int64Val(constant.ToInt(tv.Value)); exact && time.Duration(duration) <= r.maxDuration {
+				return true // short sleeps are tolerated
+			}
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "bad practice",
+			Confidence: 0.8,
+			Node:       call,
+			Failure:    "time.Sleep in tests makes them slow and flaky, synchronize with channels, sync.WaitGroup or polling instead",
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoSleepInTestsRule) Name() string {
+	return "no-sleep-in-tests"
+}
@@ -0,0 +1,77 @@
+package rule
+
+import (
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// NoTimeTickRule lints calls to time.Tick.
+type NoTimeTickRule struct{}
+
+// Apply applies the rule to given file.
+func (*NoTimeTickRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	timePkg := importName(file.AST, "time")
+	if timePkg == "" {
+		return nil // time is not imported
+	}
+
+	w := lintNoTimeTick{timePkg, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*NoTimeTickRule) Name() string {
+	return "no-time-tick"
+}
+
+type lintNoTimeTick struct {
+	timePkg   string
+	onFailure func(lint.Failure)
+}
+
+func (w lintNoTimeTick) Visit(node ast.Node) ast.Visitor {
+	ce, ok := node.(*ast.CallExpr)
+	if !ok || !isPkgDot(ce.Fun, w.timePkg, "Tick") {
+		return w
+	}
+
+	w.onFailure(lint.Failure{
+		Confidence: 1,
+		Node:       ce,
+		Category:   "bad practice",
+		Failure:    "the ticker created by time.Tick can not be stopped, use time.NewTicker and call its Stop method when done",
+	})
+
+	return w
+}
+
+// importName returns the name under which the given package is imported in the file,
+// or an empty string if it is not imported (or it is dot or blank imported)
+func importName(file *ast.File, path string) string {
+	for _, imp := range file.Imports {
+		importPath, err := strconv.Unquote(imp.Path.Value)
+		if err != nil || importPath != path {
+			continue
+		}
+
+		if imp.Name == nil {
+			return path[strings.LastIndex(path, "/")+1:]
+		}
+		if imp.Name.Name == "." || imp.Name.Name == "_" {
+			return ""
+		}
+		return imp.Name.Name
+	}
+
+	return ""
+}
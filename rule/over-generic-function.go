@@ -0,0 +1,106 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// OverGenericFunctionRule spots exported generic functions that are always instantiated with the same type arguments within their package.
+type OverGenericFunctionRule struct{}
+
+// Apply applies the rule to given file.
+func (*OverGenericFunctionRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+
+	generics := map[*types.Func]*ast.FuncDecl{}
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Type.TypeParams == nil || !fn.Name.IsExported() {
+			continue
+		}
+		if obj, ok := info.Defs[fn.Name].(*types.Func); ok {
+			generics[obj] = fn
+		}
+	}
+
+	if len(generics) == 0 {
+		return nil
+	}
+
+	instantiations := genericInstantiations(file.Pkg, generics)
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		obj, ok := info.Defs[fn.Name].(*types.Func)
+		if !ok || generics[obj] == nil || len(instantiations[obj]) != 1 {
+			continue
+		}
+
+		var typeArgs string
+		for args := range instantiations[obj] {
+			typeArgs = args
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "style",
+			Confidence: 0.5,
+			Node:       fn.Name,
+			Failure:    fmt.Sprintf("generic function %s is only instantiated with [%s] in its package, consider making it non-generic", fn.Name.Name, typeArgs),
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*OverGenericFunctionRule) Name() string {
+	return "over-generic-function"
+}
+
+// genericInstantiations returns, for each of the given generic functions,
+// the set of type arguments it is instantiated with in the package
+func genericInstantiations(pkg *lint.Package, generics map[*types.Func]*ast.FuncDecl) map[*types.Func]map[string]bool {
+	info := pkg.TypesInfo()
+	qualifier := types.RelativeTo(pkg.TypesPkg())
+	result := map[*types.Func]map[string]bool{}
+	for _, f := range pkg.Files() {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			id, ok := n.(*ast.Ident)
+			if !ok {
+				return true
+			}
+
+			fn, ok := info.Uses[id].(*types.Func)
+			if !ok || generics[fn] == nil {
+				return true
+			}
+
+			instance, ok := info.Instances[id]
+			if !ok || instance.TypeArgs == nil {
+				return true
+			}
+
+			args := make([]string, instance.TypeArgs.Len())
+			for i := range args {
+				args[i] = types.TypeString(instance.TypeArgs.At(i), qualifier)
+			}
+
+			if result[fn] == nil {
+				result[fn] = map[string]bool{}
+			}
+			result[fn][strings.Join(args, ", ")] = true
+			return true
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,94 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// PanicValueTypeRule spots panics with values that do not carry a meaningful message.
+type PanicValueTypeRule struct{}
+
+// Apply applies the rule to given file.
+func (*PanicValueTypeRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintPanicValueType{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PanicValueTypeRule) Name() string {
+	return "panic-value-type"
+}
+
+type lintPanicValueType struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintPanicValueType) Visit(node ast.Node) ast.Visitor {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 {
+		return w
+	}
+
+	id, ok := call.Fun.(*ast.Ident)
+	if !ok || id.Name != "panic" {
+		return w
+	}
+
+	if _, ok := w.file.Pkg.TypesInfo().Uses[id].(*types.Builtin); !ok {
+		return w // panic is redefined
+	}
+
+	t := w.file.Pkg.TypeOf(call.Args[0])
+	if t == nil || isMeaningfulPanicValue(t) {
+		return w
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "errors",
+		Confidence: 0.8,
+		Node:       call,
+		Failure:    fmt.Sprintf("panic with a value of type %s, use a string, an error or a fmt.Stringer with a meaningful message instead", t.String()),
+	})
+
+	return w
+}
+
+// isMeaningfulPanicValue returns true if the given type is a string, an error, a fmt.Stringer
+// or an interface whose dynamic value is unknown.
+func isMeaningfulPanicValue(t types.Type) bool {
+	switch u := t.Underlying().(type) {
+	case *types.Basic:
+		if u.Info()&types.IsString != 0 {
+			return true
+		}
+	case *types.Interface:
+		return true
+	}
+
+	if implementsError(t) {
+		return true
+	}
+
+	str, _, _ := types.LookupFieldOrMethod(t, false, nil, "String")
+	fn, ok := str.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	return sig.Params().Len() == 0 && sig.Results().Len() == 1 && types.Identical(sig.Results().At(0).Type(), types.Typ[types.String])
+}
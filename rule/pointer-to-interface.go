@@ -0,0 +1,170 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const ioInterfacesArgument = "ioInterfaces"
+
+// PointerToInterfaceRule spots function parameters whose type is a pointer to an interface.
+type PointerToInterfaceRule struct {
+	configured   bool
+	ioInterfaces bool
+	sync.Mutex
+}
+
+func (r *PointerToInterfaceRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	for _, arg := range arguments {
+		flag, ok := arg.(string)
+		if !ok || flag != ioInterfacesArgument {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q", arg, r.Name(), ioInterfacesArgument))
+		}
+		r.ioInterfaces = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *PointerToInterfaceRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintPointerToInterface{file: file, ioInterfaces: r.ioInterfaces, bodies: map[*ast.FuncType]*ast.BlockStmt{}, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PointerToInterfaceRule) Name() string {
+	return "pointer-to-interface"
+}
+
+type lintPointerToInterface struct {
+	file         *lint.File
+	ioInterfaces bool
+	bodies       map[*ast.FuncType]*ast.BlockStmt // bodies of the functions, by function type
+	onFailure    func(lint.Failure)
+}
+
+func (w lintPointerToInterface) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		w.bodies[n.Type] = n.Body
+		return w
+	case *ast.FuncLit:
+		w.bodies[n.Type] = n.Body
+		return w
+	}
+
+	ft, ok := node.(*ast.FuncType)
+	if !ok || ft.Params == nil {
+		return w
+	}
+
+	for _, field := range ft.Params.List {
+		star, ok := field.Type.(*ast.StarExpr)
+		if !ok {
+			continue
+		}
+
+		t := w.file.Pkg.TypeOf(star.X)
+		if t == nil {
+			continue
+		}
+		if _, isTypeParam := t.(*types.TypeParam); isTypeParam {
+			continue
+		}
+		if _, isInterface := t.Underlying().(*types.Interface); !isInterface {
+			continue
+		}
+		if types.Identical(t, types.Universe.Lookup("error").Type()) {
+			continue // *error is the idiomatic way to let a (deferred) function set an error
+		}
+		if w.isAssignedThrough(field, w.bodies[ft]) {
+			continue // an output parameter
+		}
+
+		msg := fmt.Sprintf("parameter of type %s is a pointer to an interface, interface values already hold a reference to their dynamic value; use %s instead", gofmt(field.Type), gofmt(star.X))
+		if w.ioInterfaces && w.isIOInterface(t) {
+			msg = fmt.Sprintf("parameter of type %s is a pointer to the interface %s; use %s instead, values like *os.File, *bytes.Buffer or net.Conn can be passed to it directly", gofmt(field.Type), gofmt(star.X), gofmt(star.X))
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "bad practice",
+			Confidence: 1,
+			Node:       field,
+			Failure:    msg,
+		})
+	}
+
+	return w
+}
+
+// isAssignedThrough returns true if the given body assigns a value through one of the pointers declared by the given field
+// (e.g. *p = v)
+func (w lintPointerToInterface) isAssignedThrough(field *ast.Field, body *ast.BlockStmt) bool {
+	if body == nil {
+		return false
+	}
+
+	params := map[types.Object]bool{}
+	for _, name := range field.Names {
+		if obj := w.file.Pkg.TypesInfo().Defs[name]; obj != nil {
+			params[obj] = true
+		}
+	}
+
+	found := false
+	ast.Inspect(body, func(n ast.Node) bool {
+		assign, ok := n.(*ast.AssignStmt)
+		if found || !ok {
+			return !found
+		}
+
+		for _, lhs := range assign.Lhs {
+			star, ok := unparen(lhs).(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			id, ok := unparen(star.X).(*ast.Ident)
+			if ok && params[w.file.Pkg.TypesInfo().Uses[id]] {
+				found = true
+				break
+			}
+		}
+
+		return !found
+	})
+
+	return found
+}
+
+// isIOInterface returns true if the given type is one of the interfaces declared in the io package
+func (lintPointerToInterface) isIOInterface(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+	return obj != nil && obj.Pkg() != nil && obj.Pkg().Path() == "io"
+}
@@ -0,0 +1,176 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// PreferFilepathJoinRule spots paths built by string concatenation.
+type PreferFilepathJoinRule struct {
+	configured        bool
+	pathLikeNamesOnly bool
+	sync.Mutex
+}
+
+func (r *PreferFilepathJoinRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting a k,v map, got %T", arguments[0], r.Name(), arguments[0]))
+	}
+
+	for k, v := range args {
+		switch k {
+		case "pathLikeNamesOnly":
+			r.pathLikeNamesOnly, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid value '%v' for argument '%s' of rule '%s'. Expecting a boolean, got %T", v, k, r.Name(), v))
+			}
+		default:
+			panic(fmt.Sprintf("Unknown argument '%s' for rule '%s'", k, r.Name()))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *PreferFilepathJoinRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintPreferFilepathJoin{file: file, pathLikeNamesOnly: r.pathLikeNamesOnly, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PreferFilepathJoinRule) Name() string {
+	return "prefer-filepath-join"
+}
+
+type lintPreferFilepathJoin struct {
+	file              *lint.File
+	pathLikeNamesOnly bool
+	onFailure         func(lint.Failure)
+}
+
+var pathLikeNameRE = regexp.MustCompile(`(?i)(path|dir|file|folder|root|base)`)
+
+func (w lintPreferFilepathJoin) Visit(node ast.Node) ast.Visitor {
+	expr, ok := node.(*ast.BinaryExpr)
+	if !ok || expr.Op != token.ADD {
+		return w
+	}
+
+	operands := concatenationOperands(expr)
+	isURL := false
+	inHost := false // true while operands are part of the scheme or host of an URL
+	found := false
+	for i, op := range operands {
+		lit, ok := op.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			continue
+		}
+
+		if strings.ContainsAny(value, "?&=") {
+			return nil // most likely an URL query, not a path
+		}
+
+		// only a separator between two non constant segments (e.g. dir + "/" + file) is reliably a path separator
+		if value == "/" && i > 0 && i < len(operands)-1 && !inHost && w.areSegments(operands[i-1], operands[i+1]) {
+			found = true
+		}
+
+		if idx := strings.Index(value, "://"); idx >= 0 {
+			isURL = true
+			inHost = !strings.Contains(value[idx+len("://"):], "/")
+		} else if inHost && strings.Contains(value, "/") {
+			inHost = false
+		}
+	}
+
+	if !found {
+		return nil
+	}
+
+	failure := "path built by concatenating strings, use filepath.Join instead"
+	if isURL {
+		failure = "URL path built by concatenating strings, use path.Join instead"
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "best-practices",
+		Confidence: 0.6,
+		Node:       expr,
+		Failure:    failure,
+	})
+
+	return nil
+}
+
+// areSegments returns true if the given expressions are non constant expressions
+// that can be the segments of a path.
+func (w lintPreferFilepathJoin) areSegments(left, right ast.Expr) bool {
+	info := w.file.Pkg.TypesInfo()
+	for _, expr := range []ast.Expr{left, right} {
+		if tv, ok := info.Types[expr]; !ok || tv.Value != nil {
+			return false // unknown or constant expression
+		}
+	}
+
+	return !w.pathLikeNamesOnly || hasPathLikeName(left) || hasPathLikeName(right)
+}
+
+func hasPathLikeName(expr ast.Expr) bool {
+	switch e := expr.(type) {
+	case *ast.Ident:
+		return pathLikeNameRE.MatchString(e.Name)
+	case *ast.SelectorExpr:
+		return pathLikeNameRE.MatchString(e.Sel.Name)
+	}
+
+	return false
+}
+
+// concatenationOperands returns the operands of a chain of + operations
+func concatenationOperands(expr ast.Expr) []ast.Expr {
+	switch e := expr.(type) {
+	case *ast.BinaryExpr:
+		if e.Op == token.ADD {
+			return append(concatenationOperands(e.X), concatenationOperands(e.Y)...)
+		}
+	case *ast.ParenExpr:
+		return concatenationOperands(e.X)
+	}
+
+	return []ast.Expr{expr}
+}
@@ -0,0 +1,171 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// PreferSwitchRule spots if-else-if chains comparing the same expression against constants.
+type PreferSwitchRule struct {
+	minLength int
+	sync.Mutex
+}
+
+const defaultPreferSwitchMinLength = 3
+
+func (r *PreferSwitchRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.minLength != 0 {
+		return
+	}
+
+	r.minLength = defaultPreferSwitchMinLength
+	if len(arguments) < 1 {
+		return
+	}
+
+	minLength, ok := arguments[0].(int64)
+	if !ok || minLength < 2 {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting an integer greater than 1, got %v", r.Name(), arguments[0]))
+	}
+	r.minLength = int(minLength)
+}
+
+// Apply applies the rule to given file.
+func (r *PreferSwitchRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+
+	inChain := map[*ast.IfStmt]bool{}
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		ifStmt, ok := n.(*ast.IfStmt)
+		if !ok || inChain[ifStmt] {
+			return true
+		}
+
+		var subject string
+		seen := map[string]bool{}
+		length := 0
+		for link := ifStmt; link != nil; {
+			if subject != "" && link.Init != nil {
+				break // a switch can only have the initialization of the first if
+			}
+
+			s, constants := comparedToConstants(info, link.Cond)
+			if s == "" || (subject != "" && s != subject) || !allNew(seen, constants) {
+				break
+			}
+			subject = s
+			length++
+			inChain[link] = true
+
+			link, _ = link.Else.(*ast.IfStmt)
+		}
+
+		if length >= r.minLength {
+			failures = append(failures, lint.Failure{
+				Category:   "style",
+				Confidence: 1,
+				Node:       ifStmt,
+				Failure:    fmt.Sprintf("this if-else-if chain compares %s against constants in %d conditions, use a switch on %s instead", subject, length, subject),
+			})
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PreferSwitchRule) Name() string {
+	return "prefer-switch"
+}
+
+// comparedToConstants returns the expression compared for equality against constants by the condition (e.g. x == 1 || x == 2)
+// along with these constants; the returned expression is empty if the condition is not such a comparison
+func comparedToConstants(info *types.Info, cond ast.Expr) (subject string, constants []string) {
+	bin, ok := unparen(cond).(*ast.BinaryExpr)
+	if !ok {
+		return "", nil
+	}
+
+	switch bin.Op {
+	case token.LOR:
+		left, leftConstants := comparedToConstants(info, bin.X)
+		right, rightConstants := comparedToConstants(info, bin.Y)
+		if left == "" || left != right {
+			return "", nil
+		}
+		return left, append(leftConstants, rightConstants...)
+	case token.EQL:
+		x, y := unparen(bin.X), unparen(bin.Y)
+		if isConstant(info, x) {
+			x, y = y, x
+		}
+		if !isConstant(info, y) || isConstant(info, x) || hasCall(x) {
+			return "", nil
+		}
+		return gofmt(x), []string{constantValue(info, y)}
+	}
+
+	return "", nil
+}
+
+// isConstant returns true if the expression is a constant, or a literal when type information is not available
+func isConstant(info *types.Info, expr ast.Expr) bool {
+	if info != nil {
+		tv, ok := info.Types[expr]
+		return ok && tv.Value != nil
+	}
+
+	_, ok := expr.(*ast.BasicLit)
+	return ok
+}
+
+// constantValue returns a representation of the value of the constant expression
+func constantValue(info *types.Info, expr ast.Expr) string {
+	if info != nil {
+		if tv, ok := info.Types[expr]; ok && tv.Value != nil {
+			return tv.Value.ExactString()
+		}
+	}
+
+	return gofmt(expr)
+}
+
+// hasCall returns true if evaluating the expression might call a function, thus have side effects
+func hasCall(expr ast.Expr) bool {
+	found := false
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.CallExpr, *ast.FuncLit:
+			found = true
+		}
+		return !found
+	})
+
+	return found
+}
+
+// allNew returns true if none of the values is in seen, and adds them to it
+func allNew(seen map[string]bool, values []string) bool {
+	for _, v := range values {
+		if seen[v] {
+			return false
+		}
+		seen[v] = true
+	}
+
+	return true
+}
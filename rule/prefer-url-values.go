@@ -0,0 +1,76 @@
+package rule
+
+import (
+	"go/ast"
+	"go/token"
+	"regexp"
+	"strconv"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// PreferURLValuesRule spots URL queries built by string concatenation.
+type PreferURLValuesRule struct{}
+
+// Apply applies the rule to given file.
+func (*PreferURLValuesRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintPreferURLValues{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PreferURLValuesRule) Name() string {
+	return "prefer-url-values"
+}
+
+type lintPreferURLValues struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+// queryParamRE matches strings ending with the beginning of a query parameter, like "?q=" or "&page="
+var queryParamRE = regexp.MustCompile(`[?&][^?&=/\s]*=$`)
+
+func (w lintPreferURLValues) Visit(node ast.Node) ast.Visitor {
+	expr, ok := node.(*ast.BinaryExpr)
+	if !ok || expr.Op != token.ADD {
+		return w
+	}
+
+	operands := concatenationOperands(expr)
+	for i, op := range operands[:len(operands)-1] {
+		lit, ok := op.(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil || !queryParamRE.MatchString(value) {
+			continue
+		}
+
+		if tv, ok := w.file.Pkg.TypesInfo().Types[operands[i+1]]; !ok || tv.Value != nil {
+			continue // unknown or constant expression
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "best-practices",
+			Confidence: 0.8,
+			Node:       expr,
+			Failure:    "URL query built by concatenating strings, use url.Values to properly escape its parameters",
+		})
+		break
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// PrematureInterfaceRule spots exported interfaces having a single implementation in their package.
+type PrematureInterfaceRule struct{}
+
+// Apply applies the rule to given file.
+func (*PrematureInterfaceRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	pkg := file.Pkg.TypesPkg()
+	if pkg == nil {
+		return nil
+	}
+
+	info := file.Pkg.TypesInfo()
+	for _, decl := range file.AST.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.TYPE {
+			continue
+		}
+
+		for _, spec := range gd.Specs {
+			ts, ok := spec.(*ast.TypeSpec)
+			if !ok || !ts.Name.IsExported() || ts.TypeParams != nil || ts.Assign.IsValid() {
+				continue
+			}
+
+			obj, ok := info.Defs[ts.Name].(*types.TypeName)
+			if !ok {
+				continue
+			}
+
+			iface, ok := obj.Type().Underlying().(*types.Interface)
+			if !ok || iface.NumMethods() == 0 {
+				continue
+			}
+
+			implementations := implementationsIn(pkg, iface)
+			if len(implementations) != 1 {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "design",
+				Confidence: 0.5,
+				Node:       ts.Name,
+				Failure:    fmt.Sprintf("interface %s has a single implementation (%s) in its package, consider defining it where it is consumed instead", ts.Name.Name, implementations[0].Name()),
+			})
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*PrematureInterfaceRule) Name() string {
+	return "premature-interface"
+}
+
+// implementationsIn returns the concrete types declared at package level of pkg that implement iface,
+// either directly or through a pointer
+func implementationsIn(pkg *types.Package, iface *types.Interface) []*types.TypeName {
+	var result []*types.TypeName
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		tn, ok := scope.Lookup(name).(*types.TypeName)
+		if !ok || tn.IsAlias() {
+			continue
+		}
+
+		named, ok := tn.Type().(*types.Named)
+		if !ok || named.TypeParams().Len() > 0 {
+			continue
+		}
+
+		if _, isInterface := named.Underlying().(*types.Interface); isInterface {
+			continue
+		}
+
+		if types.Implements(named, iface) || types.Implements(types.NewPointer(named), iface) {
+			result = append(result, tn)
+		}
+	}
+
+	return result
+}
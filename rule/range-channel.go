@@ -0,0 +1,191 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// RangeChannelRule spots range loops over channels that might never be closed.
+type RangeChannelRule struct {
+	allow map[string]bool
+	sync.Mutex
+}
+
+func (r *RangeChannelRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	if r.allow == nil {
+		r.allow = r.allowFromArgs(arguments)
+	}
+	r.Unlock()
+}
+
+func (r *RangeChannelRule) allowFromArgs(args lint.Arguments) map[string]bool {
+	if len(args) < 1 {
+		return map[string]bool{
+			"params": true,
+			"fields": true,
+		}
+	}
+
+	aa, ok := args[0].([]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting []string, got %T", args[0], r.Name(), args[0]))
+	}
+
+	allow := make(map[string]bool, len(aa))
+	for _, kind := range aa {
+		k, ok := kind.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting string, got %T", kind, r.Name(), kind))
+		}
+		allow[k] = true
+	}
+
+	return allow
+}
+
+// Apply applies the rule to given file.
+func (r *RangeChannelRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := &lintRangeChannel{
+		file:      file,
+		allow:     r.allow,
+		params:    map[types.Object]bool{},
+		closed:    closedChannelNames(file.Pkg),
+		onFailure: onFailure,
+	}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*RangeChannelRule) Name() string {
+	return "range-channel"
+}
+
+// closedChannelNames returns the names of all the channels closed in the given package.
+// For a selector like s.ch, the selected name (ch) is retained.
+func closedChannelNames(pkg *lint.Package) map[string]bool {
+	result := map[string]bool{}
+	for _, f := range pkg.Files() {
+		ast.Inspect(f.AST, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || !isIdent(call.Fun, "close") || len(call.Args) != 1 {
+				return true
+			}
+
+			switch arg := call.Args[0].(type) {
+			case *ast.Ident:
+				result[arg.Name] = true
+			case *ast.SelectorExpr:
+				result[arg.Sel.Name] = true
+			}
+
+			return true
+		})
+	}
+
+	return result
+}
+
+type lintRangeChannel struct {
+	file      *lint.File
+	allow     map[string]bool
+	params    map[types.Object]bool
+	closed    map[string]bool
+	onFailure func(lint.Failure)
+}
+
+func (w *lintRangeChannel) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.FuncType:
+		w.addParams(n)
+	case *ast.RangeStmt:
+		w.checkRange(n)
+	}
+
+	return w
+}
+
+func (w *lintRangeChannel) addParams(ft *ast.FuncType) {
+	if ft.Params == nil {
+		return
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	for _, field := range ft.Params.List {
+		for _, name := range field.Names {
+			if obj := info.Defs[name]; obj != nil {
+				w.params[obj] = true
+			}
+		}
+	}
+}
+
+func (w *lintRangeChannel) checkRange(rs *ast.RangeStmt) {
+	t := w.file.Pkg.TypeOf(rs.X)
+	if t == nil {
+		return
+	}
+
+	if _, ok := t.Underlying().(*types.Chan); !ok {
+		return
+	}
+
+	var name string
+	info := w.file.Pkg.TypesInfo()
+	switch x := rs.X.(type) {
+	case *ast.Ident:
+		if !w.allow["params"] || !w.params[info.Uses[x]] {
+			return
+		}
+		name = x.Name
+	case *ast.SelectorExpr:
+		v, ok := info.Uses[x.Sel].(*types.Var)
+		if !w.allow["fields"] || !ok || !v.IsField() {
+			return
+		}
+		name = x.Sel.Name
+	default:
+		return
+	}
+
+	if w.closed[name] || w.listensContextDone(rs.Body) {
+		return
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 0.5,
+		Node:       rs,
+		Failure:    fmt.Sprintf("range over channel %s that is never closed in this package, the loop might block forever", gofmt(rs.X)),
+	})
+}
+
+// listensContextDone returns true if the given block calls the Done method of a context.Context.
+func (w *lintRangeChannel) listensContextDone(body *ast.BlockStmt) bool {
+	isContextDone := func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		return ok && sel.Sel.Name == "Done" && isNamedType(w.file.Pkg.TypeOf(sel.X), "context", "Context")
+	}
+
+	return len(pick(body, isContextDone)) > 0
+}
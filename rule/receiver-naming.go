@@ -3,16 +3,43 @@ package rule
 import (
 	"fmt"
 	"go/ast"
+	"go/token"
+	"strings"
+	"sync"
+	"unicode"
 
 	"github.com/mgechev/revive/internal/typeparams"
 	"github.com/mgechev/revive/lint"
 )
 
 // ReceiverNamingRule lints given else constructs.
-type ReceiverNamingRule struct{}
+type ReceiverNamingRule struct {
+	bannedNames map[string]bool
+	sync.Mutex
+}
+
+func (r *ReceiverNamingRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.bannedNames != nil {
+		return
+	}
+
+	r.bannedNames = map[string]bool{"this": true, "self": true}
+	for _, arg := range arguments {
+		name, ok := arg.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+		}
+		r.bannedNames[name] = true
+	}
+}
 
 // Apply applies the rule to given file.
-func (*ReceiverNamingRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+func (r *ReceiverNamingRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
 	var failures []lint.Failure
 
 	fileAst := file.AST
@@ -21,6 +48,7 @@ func (*ReceiverNamingRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failu
 			failures = append(failures, failure)
 		},
 		typeReceiver: map[string]string{},
+		bannedNames:  r.bannedNames,
 	}
 
 	ast.Walk(walker, fileAst)
@@ -36,6 +64,7 @@ func (*ReceiverNamingRule) Name() string {
 type lintReceiverName struct {
 	onFailure    func(lint.Failure)
 	typeReceiver map[string]string
+	bannedNames  map[string]bool
 }
 
 func (w lintReceiverName) Visit(n ast.Node) ast.Visitor {
@@ -57,16 +86,20 @@ func (w lintReceiverName) Visit(n ast.Node) ast.Visitor {
 		})
 		return w
 	}
-	if name == "this" || name == "self" {
+	recv := typeparams.ReceiverType(fn)
+	if w.bannedNames[name] {
+		msg := fmt.Sprintf("receiver name should be a reflection of its identity; don't use the generic name %q", name)
+		if suggestion := w.receiverNameFor(recv); suggestion != "" {
+			msg += fmt.Sprintf(", consider naming it %s", suggestion)
+		}
 		w.onFailure(lint.Failure{
-			Node:       n,
+			Node:       fn.Recv.List[0],
 			Confidence: 1,
 			Category:   "naming",
-			Failure:    `receiver name should be a reflection of its identity; don't use generic names such as "this" or "self"`,
+			Failure:    msg,
 		})
 		return w
 	}
-	recv := typeparams.ReceiverType(fn)
 	if prev, ok := w.typeReceiver[recv]; ok && prev != name {
 		w.onFailure(lint.Failure{
 			Node:       n,
@@ -79,3 +112,27 @@ func (w lintReceiverName) Visit(n ast.Node) ast.Visitor {
 	w.typeReceiver[recv] = name
 	return w
 }
+
+// receiverNameFor returns a short receiver name derived from the given type name:
+// the lowercased initials of its words if there are at most two of them (e.g. me for multiError),
+// the lowercased first letter otherwise, or an empty string if none can be derived.
+func (w lintReceiverName) receiverNameFor(typeName string) string {
+	if typeName == "invalid-type" {
+		return ""
+	}
+
+	var initials strings.Builder
+	for i, r := range typeName {
+		if i == 0 || unicode.IsUpper(r) {
+			initials.WriteRune(unicode.ToLower(r))
+		}
+	}
+
+	name := initials.String()
+	if len([]rune(name)) > 2 || token.IsKeyword(name) || w.bannedNames[name] {
+		// fallback to the first letter
+		name = string(unicode.ToLower([]rune(typeName)[0]))
+	}
+
+	return name
+}
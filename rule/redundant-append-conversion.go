@@ -0,0 +1,98 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// RedundantAppendConversionRule spots unnecessary conversions of the spread argument of append.
+type RedundantAppendConversionRule struct{}
+
+// Apply applies the rule to given file.
+func (*RedundantAppendConversionRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintRedundantAppendConversion{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*RedundantAppendConversionRule) Name() string {
+	return "redundant-append-conversion"
+}
+
+type lintRedundantAppendConversion struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintRedundantAppendConversion) Visit(node ast.Node) ast.Visitor {
+	call, ok := node.(*ast.CallExpr)
+	if !ok || !call.Ellipsis.IsValid() || len(call.Args) != 2 {
+		return w
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	fn, ok := call.Fun.(*ast.Ident)
+	if !ok || fn.Name != "append" {
+		return w
+	}
+	if _, isBuiltin := info.Uses[fn].(*types.Builtin); !isBuiltin {
+		return w
+	}
+
+	conversion, ok := call.Args[1].(*ast.CallExpr)
+	if !ok || len(conversion.Args) != 1 || !info.Types[conversion.Fun].IsType() {
+		return w
+	}
+
+	target := w.file.Pkg.TypeOf(conversion.Fun)
+	dest := w.file.Pkg.TypeOf(call.Args[0])
+	source := w.file.Pkg.TypeOf(conversion.Args[0])
+	if target == nil || dest == nil || source == nil {
+		return w
+	}
+
+	switch {
+	case types.Identical(source, target):
+		// append(b, []byte(b2)...) with b2 of type []byte
+	case isByteSlice(target) && isByteSlice(dest) && isString(source):
+		// append(b, []byte(s)...) with s of type string
+	default:
+		return w
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "optimization",
+		Confidence: 1,
+		Node:       conversion,
+		Failure:    fmt.Sprintf("unnecessary conversion, use append(%s, %s...) instead", gofmt(call.Args[0]), gofmt(conversion.Args[0])),
+	})
+
+	return w
+}
+
+func isByteSlice(t types.Type) bool {
+	slice, ok := t.Underlying().(*types.Slice)
+	if !ok {
+		return false
+	}
+	elem, ok := slice.Elem().Underlying().(*types.Basic)
+	return ok && elem.Kind() == types.Byte
+}
+
+func isString(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
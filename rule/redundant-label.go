@@ -0,0 +1,126 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// RedundantLabelRule spots labeled break and continue statements whose label is not needed.
+type RedundantLabelRule struct{}
+
+// Apply applies the rule to given file.
+func (*RedundantLabelRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			checkRedundantLabels(n.Body, onFailure)
+		case *ast.FuncLit:
+			checkRedundantLabels(n.Body, onFailure)
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*RedundantLabelRule) Name() string {
+	return "redundant-label"
+}
+
+// checkRedundantLabels spots the redundant labels of the function body; labels are scoped to the function declaring them
+func checkRedundantLabels(body *ast.BlockStmt, onFailure func(lint.Failure)) {
+	if body == nil {
+		return
+	}
+
+	var labels []*ast.LabeledStmt
+	uses := map[string]int{}
+	redundantUses := map[string]int{}
+	var parents []ast.Node
+	ast.Inspect(body, func(n ast.Node) bool {
+		if n == nil {
+			parents = parents[:len(parents)-1]
+			return true
+		}
+
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // checked on its own
+		case *ast.LabeledStmt:
+			labels = append(labels, n)
+		case *ast.BranchStmt:
+			if n.Label == nil {
+				break
+			}
+			uses[n.Label.Name]++
+			if kind := redundantLabelTarget(n, parents); kind != "" {
+				redundantUses[n.Label.Name]++
+				onFailure(lint.Failure{
+					Category:   "style",
+					Confidence: 1,
+					Node:       n,
+					Failure:    fmt.Sprintf("%s %s is redundant, the bare %s applies to the same %s; remove the label", n.Tok, n.Label.Name, n.Tok, kind),
+				})
+			}
+		}
+
+		parents = append(parents, n)
+		return true
+	})
+
+	for _, labeled := range labels {
+		name := labeled.Label.Name
+		if uses[name] > 0 && uses[name] == redundantUses[name] {
+			onFailure(lint.Failure{
+				Category:   "style",
+				Confidence: 1,
+				Node:       labeled.Label,
+				Failure:    fmt.Sprintf("label %s is only used by redundant branch statements, remove it", name),
+			})
+		}
+	}
+}
+
+// redundantLabelTarget returns the kind of statement (loop, switch or select) targeted by the labeled branch statement
+// if its label is the one of the innermost statement the bare branch statement would target; otherwise it returns an empty string
+func redundantLabelTarget(branch *ast.BranchStmt, parents []ast.Node) string {
+	if branch.Tok != token.BREAK && branch.Tok != token.CONTINUE {
+		return ""
+	}
+
+	for i := len(parents) - 1; i > 0; i-- {
+		var kind string
+		switch parents[i].(type) {
+		case *ast.ForStmt, *ast.RangeStmt:
+			kind = "loop"
+		case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+			kind = "switch"
+		case *ast.SelectStmt:
+			kind = "select"
+		default:
+			continue
+		}
+
+		if branch.Tok == token.CONTINUE && kind != "loop" {
+			continue // continue only applies to loops
+		}
+
+		labeled, ok := parents[i-1].(*ast.LabeledStmt)
+		if ok && labeled.Stmt == parents[i] && labeled.Label.Name == branch.Label.Name {
+			return kind
+		}
+		return ""
+	}
+
+	return ""
+}
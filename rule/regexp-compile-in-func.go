@@ -0,0 +1,112 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// RegexpCompileInFuncRule spots regular expressions compiled inside function bodies.
+type RegexpCompileInFuncRule struct {
+	allow map[string]bool
+	sync.Mutex
+}
+
+func (r *RegexpCompileInFuncRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	if r.allow == nil {
+		r.allow = r.allowFromArgs(arguments)
+	}
+	r.Unlock()
+}
+
+func (r *RegexpCompileInFuncRule) allowFromArgs(args lint.Arguments) map[string]bool {
+	allow := map[string]bool{}
+	if len(args) < 1 {
+		return allow
+	}
+
+	aa, ok := args[0].([]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting []string, got %T", args[0], r.Name(), args[0]))
+	}
+
+	for _, place := range aa {
+		p, ok := place.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting string, got %T", place, r.Name(), place))
+		}
+		allow[p] = true
+	}
+
+	return allow
+}
+
+// Apply applies the rule to given file.
+func (r *RegexpCompileInFuncRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	if r.allow["tests"] && file.IsTest() {
+		return nil
+	}
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	w := lintRegexpCompileInFunc{onFailure: onFailure, allowInit: r.allow["init"]}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*RegexpCompileInFuncRule) Name() string {
+	return "regexp-compile-in-func"
+}
+
+type lintRegexpCompileInFunc struct {
+	onFailure func(lint.Failure)
+	allowInit bool
+}
+
+var regexpCompileFuncs = []string{"Compile", "CompilePOSIX", "MustCompile", "MustCompilePOSIX"}
+
+func (w lintRegexpCompileInFunc) Visit(node ast.Node) ast.Visitor {
+	fd, ok := node.(*ast.FuncDecl)
+	if !ok {
+		return w
+	}
+
+	if fd.Body == nil || (w.allowInit && fd.Recv == nil && fd.Name.Name == "init") {
+		return nil
+	}
+
+	ast.Inspect(fd.Body, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !isStringLiteral(call.Args[0]) {
+			return true // only calls with a constant pattern can be moved to the package level
+		}
+
+		for _, name := range regexpCompileFuncs {
+			if !isPkgDot(call.Fun, "regexp", name) {
+				continue
+			}
+
+			w.onFailure(lint.Failure{
+				Category:   "performance",
+				Confidence: 0.8,
+				Node:       call,
+				Failure:    fmt.Sprintf("regexp.%s is called each time %s is executed, move the compiled regular expression to a package-level variable", name, fd.Name.Name),
+			})
+			break
+		}
+
+		return true
+	})
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// ShadowedNamedResultRule spots declarations shadowing named results.
+type ShadowedNamedResultRule struct{}
+
+// Apply applies the rule to given file.
+func (*ShadowedNamedResultRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+
+	checkFunc := func(ft *ast.FuncType, body *ast.BlockStmt) {
+		if ft.Results == nil || body == nil {
+			return
+		}
+
+		results := map[string]types.Object{}
+		resultIdents := map[string]*ast.Ident{}
+		for _, field := range ft.Results.List {
+			for _, name := range field.Names {
+				if obj := info.Defs[name]; obj != nil && !isBlank(name) {
+					results[name.Name] = obj
+					resultIdents[name.Name] = name
+				}
+			}
+		}
+		if len(results) == 0 {
+			return
+		}
+
+		// shadowing a result matters only if the result is then observed through its name:
+		// by a bare return following the shadowing declaration, or by a deferred closure
+		lastBareReturn := token.NoPos
+		readByDefer := map[types.Object]bool{}
+		returned := map[types.Object]bool{} // variables explicitly returned, their values reach the results
+		ast.Inspect(body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ReturnStmt:
+				if len(n.Results) == 0 && n.Pos() > lastBareReturn {
+					lastBareReturn = n.Pos()
+				}
+				for _, result := range n.Results {
+					if id, ok := unparen(result).(*ast.Ident); ok {
+						returned[info.Uses[id]] = true
+					}
+				}
+			case *ast.DeferStmt:
+				ast.Inspect(n.Call, func(n ast.Node) bool {
+					if id, ok := n.(*ast.Ident); ok {
+						readByDefer[info.Uses[id]] = true
+					}
+					return true
+				})
+			}
+			return true
+		})
+
+		check := func(decl ast.Node, ids []*ast.Ident) {
+			var shadowed []string
+			var related []lint.RelatedInformation
+			for _, id := range ids {
+				result, ok := results[id.Name]
+				if !ok || (decl.Pos() > lastBareReturn && !readByDefer[result]) {
+					continue
+				}
+				if obj := info.Defs[id]; obj != nil && obj != result && types.AssignableTo(obj.Type(), result.Type()) && !returned[obj] {
+					shadowed = append(shadowed, id.Name)
+					related = append(related, lint.RelatedInformation{
+						Node:    resultIdents[id.Name],
+						Message: fmt.Sprintf("named result %s is declared here", id.Name),
+					})
+				}
+			}
+
+			if len(shadowed) == 0 {
+				return
+			}
+
+			msg := "this declaration shadows the named result %s, assignments to the new variable do not set the result"
+			if len(shadowed) > 1 {
+				msg = "this declaration shadows the named results %s, assignments to the new variables do not set the results"
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:           "logic",
+				Confidence:         0.8,
+				Node:               decl,
+				Failure:            fmt.Sprintf(msg, strings.Join(shadowed, ", ")),
+				RelatedInformation: related,
+			})
+		}
+
+		// variables declared in the initialization of if and switch statements are scoped to the statement
+		// and usually consumed by its condition (e.g. if v, ok := m[k]; ok { ... })
+		inStatementInit := map[ast.Stmt]bool{}
+		ast.Inspect(body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.IfStmt:
+				inStatementInit[n.Init] = true
+			case *ast.SwitchStmt:
+				inStatementInit[n.Init] = true
+			case *ast.TypeSwitchStmt:
+				inStatementInit[n.Init] = true
+			case *ast.AssignStmt:
+				if n.Tok != token.DEFINE || inStatementInit[n] {
+					return true
+				}
+				ids := make([]*ast.Ident, 0, len(n.Lhs))
+				for _, lhs := range n.Lhs {
+					if id, ok := lhs.(*ast.Ident); ok {
+						ids = append(ids, id)
+					}
+				}
+				check(n, ids)
+			case *ast.ValueSpec:
+				check(n, n.Names)
+			}
+			return true
+		})
+	}
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncDecl:
+			checkFunc(n.Type, n.Body)
+		case *ast.FuncLit:
+			checkFunc(n.Type, n.Body)
+		}
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ShadowedNamedResultRule) Name() string {
+	return "shadowed-named-result"
+}
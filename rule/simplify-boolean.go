@@ -0,0 +1,98 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// negatedOperators maps comparison operators to their negation
+var negatedOperators = map[token.Token]token.Token{
+	token.EQL: token.NEQ,
+	token.NEQ: token.EQL,
+	token.LSS: token.GEQ,
+	token.GEQ: token.LSS,
+	token.GTR: token.LEQ,
+	token.LEQ: token.GTR,
+}
+
+// SimplifyBooleanRule spots negated boolean expressions that can be simplified.
+type SimplifyBooleanRule struct{}
+
+// Apply applies the rule to given file.
+func (*SimplifyBooleanRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		not, ok := n.(*ast.UnaryExpr)
+		if !ok || not.Op != token.NOT {
+			return true
+		}
+
+		simplified := simplifiedNegation(file, not)
+		if simplified == nil {
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "style",
+			Confidence: 1,
+			Node:       not,
+			Failure:    fmt.Sprintf("%s can be simplified to %s", gofmt(not), gofmt(simplified)),
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*SimplifyBooleanRule) Name() string {
+	return "simplify-boolean"
+}
+
+// simplifiedNegation returns the simplified form of the given negation, or nil if it can not be simplified
+func simplifiedNegation(file *lint.File, not *ast.UnaryExpr) ast.Expr {
+	operand := not.X
+	for {
+		paren, ok := operand.(*ast.ParenExpr)
+		if !ok {
+			break
+		}
+		operand = paren.X
+	}
+
+	switch x := operand.(type) {
+	case *ast.UnaryExpr: // !!x
+		if x.Op == token.NOT {
+			return x.X
+		}
+	case *ast.BinaryExpr: // !(x op y)
+		negated, ok := negatedOperators[x.Op]
+		if !ok {
+			return nil
+		}
+		if x.Op != token.EQL && x.Op != token.NEQ && !isOrderedWithoutNaN(file.Pkg.TypeOf(x.X)) {
+			return nil // !(x < y) is not x >= y if x or y is NaN
+		}
+		return &ast.BinaryExpr{X: x.X, Op: negated, Y: x.Y}
+	}
+
+	return nil
+}
+
+// isOrderedWithoutNaN returns true if the given type is an ordered type without NaN values (i.e. integers and strings)
+func isOrderedWithoutNaN(t types.Type) bool {
+	if t == nil {
+		return false
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&(types.IsInteger|types.IsString) != 0
+}
@@ -0,0 +1,102 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// SliceAliasingRule spots functions returning the result of appending to a slice parameter.
+type SliceAliasingRule struct{}
+
+// Apply applies the rule to given file.
+func (*SliceAliasingRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintSliceAliasing{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*SliceAliasingRule) Name() string {
+	return "slice-aliasing"
+}
+
+type lintSliceAliasing struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintSliceAliasing) Visit(node ast.Node) ast.Visitor {
+	var ft *ast.FuncType
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		ft, body = n.Type, n.Body
+	case *ast.FuncLit:
+		ft, body = n.Type, n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	params := map[types.Object]bool{}
+	for _, field := range ft.Params.List {
+		for _, name := range field.Names {
+			obj := info.Defs[name]
+			if obj == nil {
+				continue
+			}
+			if _, ok := obj.Type().Underlying().(*types.Slice); ok {
+				params[obj] = true
+			}
+		}
+	}
+
+	if len(params) == 0 {
+		return w
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				call, ok := result.(*ast.CallExpr)
+				if !ok || !isIdent(call.Fun, "append") || len(call.Args) < 2 {
+					continue
+				}
+
+				id, ok := call.Args[0].(*ast.Ident)
+				if !ok || !params[info.Uses[id]] {
+					continue
+				}
+
+				w.onFailure(lint.Failure{
+					Category:   "logic",
+					Confidence: 0.5,
+					Node:       call,
+					Failure:    fmt.Sprintf("returning the result of appending to the parameter %s, the returned slice might share its backing array with the caller's slice", id.Name),
+				})
+			}
+		}
+		return true
+	})
+
+	return w
+}
@@ -0,0 +1,244 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// SQLRowsCloseRule spots *sql.Rows values that are never closed.
+type SQLRowsCloseRule struct{}
+
+// Apply applies the rule to given file.
+func (*SQLRowsCloseRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintUnclosedResource{
+		file: file,
+		isResource: func(t types.Type) bool {
+			ptr, ok := t.(*types.Pointer)
+			return ok && isNamedType(ptr.Elem(), "database/sql", "Rows")
+		},
+		method: "Close",
+		owner:  func(x ast.Expr) ast.Expr { return x },
+		onUnclosed: func(node ast.Node, name string, call *ast.CallExpr) {
+			msg := fmt.Sprintf("%s is never closed, add defer %s.Close() to release the database connection", name, name)
+			if name == "" {
+				msg = fmt.Sprintf("the rows returned by %s are discarded without being closed, this leaks a database connection", gofmt(call.Fun))
+			}
+			onFailure(lint.Failure{
+				Category:   "bad practice",
+				Confidence: 0.8,
+				Node:       node,
+				Failure:    msg,
+			})
+		},
+	}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*SQLRowsCloseRule) Name() string {
+	return "sql-rows-close"
+}
+
+// lintUnclosedResource spots variables holding resources that are never released in the function declaring them.
+// A resource is not reported if it is returned, passed to a function or stored elsewhere
+// because then the responsibility of closing it is (probably) transferred.
+type lintUnclosedResource struct {
+	file *lint.File
+	// isResource returns true if values of the given type must be closed
+	isResource func(types.Type) bool
+	// method is the name of the method releasing the resource (e.g. Close)
+	method string
+	// owner yields, from the receiver of a call to method, the expression that might refer to the resource
+	owner func(ast.Expr) ast.Expr
+	// mustDefer requires the call to method to be deferred
+	mustDefer bool
+	// onUnclosed is called with the name of the variable holding the unclosed resource, or an empty name if the resource is discarded
+	onUnclosed func(node ast.Node, name string, call *ast.CallExpr)
+}
+
+func (w lintUnclosedResource) Visit(node ast.Node) ast.Visitor {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	type resource struct {
+		node ast.Node
+		name string
+		call *ast.CallExpr
+	}
+	resources := map[types.Object]resource{}
+	var declared []types.Object // to report in declaration order
+	declare := func(node ast.Node, lhs []ast.Expr, call *ast.CallExpr) {
+		results := w.file.Pkg.TypeOf(call)
+		var resultTypes []types.Type
+		switch rt := results.(type) {
+		case nil:
+			return
+		case *types.Tuple:
+			for i := 0; i < rt.Len(); i++ {
+				resultTypes = append(resultTypes, rt.At(i).Type())
+			}
+		default:
+			resultTypes = append(resultTypes, rt)
+		}
+
+		if len(resultTypes) != len(lhs) {
+			return
+		}
+
+		for i, t := range resultTypes {
+			if !w.isResource(t) {
+				continue
+			}
+
+			id, ok := lhs[i].(*ast.Ident)
+			if !ok {
+				continue // stored elsewhere
+			}
+
+			if isBlank(id) {
+				w.onUnclosed(node, "", call)
+				continue
+			}
+
+			if obj := info.ObjectOf(id); obj != nil {
+				if _, alreadyDeclared := resources[obj]; !alreadyDeclared {
+					resources[obj] = resource{node, id.Name, call}
+					declared = append(declared, obj)
+				}
+			}
+		}
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.AssignStmt:
+			if len(n.Rhs) != 1 {
+				return true
+			}
+			if call, ok := n.Rhs[0].(*ast.CallExpr); ok {
+				declare(n, n.Lhs, call)
+			}
+		case *ast.ValueSpec:
+			if len(n.Values) != 1 {
+				return true
+			}
+			if call, ok := n.Values[0].(*ast.CallExpr); ok {
+				lhs := make([]ast.Expr, len(n.Names))
+				for i, name := range n.Names {
+					lhs[i] = name
+				}
+				declare(n, lhs, call)
+			}
+		}
+		return true
+	})
+
+	if len(resources) == 0 {
+		return w
+	}
+
+	// refersTo returns the resource the expression refers to, if any
+	refersTo := func(expr ast.Expr) types.Object {
+		id, ok := expr.(*ast.Ident)
+		if !ok {
+			return nil
+		}
+		obj := info.Uses[id]
+		if _, ok := resources[obj]; !ok {
+			return nil
+		}
+		return obj
+	}
+
+	deferred := map[*ast.CallExpr]bool{}
+	if w.mustDefer {
+		ast.Inspect(body, func(n ast.Node) bool {
+			if d, ok := n.(*ast.DeferStmt); ok {
+				ast.Inspect(d, func(n ast.Node) bool {
+					if call, ok := n.(*ast.CallExpr); ok {
+						deferred[call] = true
+					}
+					return true
+				})
+				return false
+			}
+			return true
+		})
+	}
+
+	// search for releases and escapes also within function literals (e.g. defer func() { rows.Close() }())
+	handled := map[types.Object]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if sel, ok := n.Fun.(*ast.SelectorExpr); ok && sel.Sel.Name == w.method && (!w.mustDefer || deferred[n]) {
+				if obj := refersTo(w.owner(sel.X)); obj != nil {
+					handled[obj] = true
+				}
+			}
+			for _, arg := range n.Args {
+				if obj := refersTo(arg); obj != nil {
+					handled[obj] = true
+				}
+			}
+		case *ast.ReturnStmt:
+			for _, result := range n.Results {
+				if obj := refersTo(result); obj != nil {
+					handled[obj] = true
+				}
+			}
+		case *ast.AssignStmt:
+			for _, rhs := range n.Rhs {
+				if obj := refersTo(rhs); obj != nil {
+					handled[obj] = true
+				}
+			}
+		case *ast.CompositeLit:
+			for _, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					elt = kv.Value
+				}
+				if obj := refersTo(elt); obj != nil {
+					handled[obj] = true
+				}
+			}
+		}
+		return true
+	})
+
+	for _, obj := range declared {
+		if !handled[obj] {
+			res := resources[obj]
+			w.onUnclosed(res.node, res.name, res.call)
+		}
+	}
+
+	return w
+}
@@ -0,0 +1,121 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// sqlContextMethods maps database/sql methods to their context-aware variants
+var sqlContextMethods = map[string]string{
+	"Exec":     "ExecContext",
+	"Prepare":  "PrepareContext",
+	"Query":    "QueryContext",
+	"QueryRow": "QueryRowContext",
+}
+
+// SQLUseContextRule spots calls to database/sql methods that have a context-aware variant.
+type SQLUseContextRule struct{}
+
+// Apply applies the rule to given file.
+func (*SQLUseContextRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintSQLUseContext{file: file, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*SQLUseContextRule) Name() string {
+	return "sql-use-context"
+}
+
+type lintSQLUseContext struct {
+	file      *lint.File
+	ctxName   string // name of the context.Context in scope, if any
+	onFailure func(lint.Failure)
+}
+
+func (w lintSQLUseContext) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		return w.withContextOf(n.Type)
+	case *ast.FuncLit:
+		return w.withContextOf(n.Type)
+	case *ast.CallExpr:
+		w.checkCall(n)
+	}
+
+	return w
+}
+
+// withContextOf returns a visitor aware of the context.Context parameter of the given function, if any
+func (w lintSQLUseContext) withContextOf(ft *ast.FuncType) lintSQLUseContext {
+	for _, field := range ft.Params.List {
+		if !isNamedType(w.file.Pkg.TypeOf(field.Type), "context", "Context") {
+			continue
+		}
+		for _, name := range field.Names {
+			if !isBlank(name) {
+				w.ctxName = name.Name
+				return w
+			}
+		}
+	}
+
+	return w
+}
+
+func (w lintSQLUseContext) checkCall(call *ast.CallExpr) {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return
+	}
+
+	alternative, ok := sqlContextMethods[sel.Sel.Name]
+	if !ok {
+		return
+	}
+
+	fn, ok := w.file.Pkg.TypesInfo().Uses[sel.Sel].(*types.Func)
+	if !ok {
+		return
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return
+	}
+
+	recv := sig.Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	if !isNamedType(recv, "database/sql", "DB") && !isNamedType(recv, "database/sql", "Tx") {
+		return
+	}
+
+	confidence := 0.8
+	suggestion := fmt.Sprintf("use %s with a context.Context to support cancellation", alternative)
+	if w.ctxName != "" {
+		confidence = 1
+		suggestion = fmt.Sprintf("use %s(%s, ...) to support cancellation", alternative, w.ctxName)
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "bad practice",
+		Confidence: confidence,
+		Node:       call,
+		Failure:    fmt.Sprintf("%s ignores context cancellation, %s", gofmt(call.Fun), suggestion),
+	})
+}
@@ -0,0 +1,156 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// StructTagAlignmentRule spots struct field tags that are not consistently aligned.
+type StructTagAlignmentRule struct{}
+
+// Apply applies the rule to given file.
+func (*StructTagAlignmentRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	w := lintStructTagAlignment{file: file, lines: strings.Split(string(file.Content()), "\n"), onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*StructTagAlignmentRule) Name() string {
+	return "struct-tag-alignment"
+}
+
+type lintStructTagAlignment struct {
+	file      *lint.File
+	lines     []string
+	onFailure func(lint.Failure)
+}
+
+// taggedField is a single-line struct field with a tag
+type taggedField struct {
+	field     *ast.Field
+	line      int    // line of the field
+	column    int    // visual column of the tag
+	separator string // whitespace between the field type and its tag
+}
+
+func (w lintStructTagAlignment) Visit(node ast.Node) ast.Visitor {
+	st, ok := node.(*ast.StructType)
+	if !ok || st.Fields == nil {
+		return w
+	}
+
+	var fields []taggedField
+	for _, field := range st.Fields.List {
+		if field.Tag == nil || len(field.Names) == 0 {
+			// breaks the alignment block: gofmt puts tags of embedded fields in a cell of their own
+			fields = append(fields, taggedField{})
+			continue
+		}
+
+		tf, ok := w.taggedField(field)
+		if !ok {
+			fields = append(fields, taggedField{})
+			continue
+		}
+		fields = append(fields, tf)
+	}
+
+	// gofmt aligns tags of consecutive lines, thus tags of a block of consecutive tagged fields must share the same column
+	var firstSeparator string
+	for i, f := range fields {
+		if f.field == nil {
+			continue
+		}
+
+		if firstSeparator == "" {
+			firstSeparator = f.separator
+		} else if strings.Contains(firstSeparator, "\t") != strings.Contains(f.separator, "\t") {
+			w.onFailure(lint.Failure{
+				Category:   "style",
+				Confidence: 1,
+				Node:       f.field,
+				Failure:    fmt.Sprintf("the tag of field %s is preceded by %s while other tags of the struct are preceded by %s", fieldName(f.field), whitespaceKind(f.separator), whitespaceKind(firstSeparator)),
+			})
+			return w
+		}
+
+		if i == 0 {
+			continue
+		}
+		prev := fields[i-1]
+		if prev.field == nil || prev.line+1 != f.line || prev.column == f.column {
+			continue
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "style",
+			Confidence: 1,
+			Node:       f.field,
+			Failure:    fmt.Sprintf("the tag of field %s is not aligned with the tag of the preceding field", fieldName(f.field)),
+		})
+		return w
+	}
+
+	return w
+}
+
+// taggedField returns the layout of the given tagged field if it spans a single line
+func (w lintStructTagAlignment) taggedField(field *ast.Field) (taggedField, bool) {
+	start := w.file.ToPosition(field.Pos())
+	typeEnd := w.file.ToPosition(field.Type.End())
+	tag := w.file.ToPosition(field.Tag.Pos())
+	if start.Line != tag.Line || typeEnd.Line != tag.Line || tag.Line > len(w.lines) {
+		return taggedField{}, false
+	}
+
+	line := w.lines[tag.Line-1]
+	if tag.Column-1 > len(line) || typeEnd.Column-1 > tag.Column-1 {
+		return taggedField{}, false
+	}
+
+	return taggedField{
+		field:     field,
+		line:      tag.Line,
+		column:    visualColumn(line[:tag.Column-1]),
+		separator: line[typeEnd.Column-1 : tag.Column-1],
+	}, true
+}
+
+// visualColumn returns the width of the given text when tabs are expanded to 8 columns
+func visualColumn(text string) int {
+	const tabWidth = 8
+	column := 0
+	for _, r := range text {
+		if r == '\t' {
+			column += tabWidth - column%tabWidth
+			continue
+		}
+		column++
+	}
+	return column
+}
+
+func whitespaceKind(separator string) string {
+	if strings.Contains(separator, "\t") {
+		return "tabs"
+	}
+	return "spaces"
+}
+
+func fieldName(field *ast.Field) string {
+	if len(field.Names) == 0 {
+		return gofmt(field.Type)
+	}
+	return field.Names[0].Name
+}
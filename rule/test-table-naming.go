@@ -0,0 +1,151 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+var defaultTestTableNames = []string{"tests", "testCases"}
+
+// TestTableNamingRule enforces a naming convention for the tables of table-driven tests.
+type TestTableNamingRule struct {
+	allowed map[string]bool
+	sync.Mutex
+}
+
+func (r *TestTableNamingRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.allowed != nil {
+		return
+	}
+
+	names := defaultTestTableNames
+	if len(arguments) > 0 {
+		names = make([]string, 0, len(arguments))
+		for _, arg := range arguments {
+			name, ok := arg.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+			}
+			names = append(names, name)
+		}
+	}
+
+	r.allowed = make(map[string]bool, len(names))
+	for _, name := range names {
+		r.allowed[name] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *TestTableNamingRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	if !file.IsTest() {
+		return nil
+	}
+
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Recv != nil || !strings.HasPrefix(fn.Name.Name, "Test") {
+			continue
+		}
+
+		for _, table := range testTables(fn.Body) {
+			if r.allowed[table.Name] {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "naming",
+				Confidence: 1,
+				Node:       table,
+				Failure:    fmt.Sprintf("test table %s should be named %s", table.Name, r.allowedNames()),
+			})
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*TestTableNamingRule) Name() string {
+	return "test-table-naming"
+}
+
+func (r *TestTableNamingRule) allowedNames() string {
+	names := make([]string, 0, len(r.allowed))
+	for name := range r.allowed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, " or ")
+}
+
+// testTables returns the identifiers declaring test tables in the given body:
+// variables initialized with a slice of structs literal that are ranged over
+func testTables(body *ast.BlockStmt) []*ast.Ident {
+	tables := map[string]*ast.Ident{}
+	var order []string
+	declare := func(id *ast.Ident, value ast.Expr) {
+		lit, ok := value.(*ast.CompositeLit)
+		if !ok {
+			return
+		}
+		slice, ok := lit.Type.(*ast.ArrayType)
+		if !ok {
+			return
+		}
+		if _, ok := slice.Elt.(*ast.StructType); !ok {
+			return
+		}
+		if _, seen := tables[id.Name]; !seen {
+			order = append(order, id.Name)
+		}
+		tables[id.Name] = id
+	}
+
+	ranged := map[string]bool{}
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			if n.Tok != token.DEFINE || len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok {
+					declare(id, n.Rhs[i])
+				}
+			}
+		case *ast.ValueSpec:
+			for i, value := range n.Values {
+				if i < len(n.Names) {
+					declare(n.Names[i], value)
+				}
+			}
+		case *ast.RangeStmt:
+			if id, ok := n.X.(*ast.Ident); ok {
+				ranged[id.Name] = true
+			}
+		}
+		return true
+	})
+
+	var result []*ast.Ident
+	for _, name := range order {
+		if ranged[name] {
+			result = append(result, tables[name])
+		}
+	}
+
+	return result
+}
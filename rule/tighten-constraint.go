@@ -0,0 +1,162 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// TightenConstraintRule spots type parameters constrained by any whose values are used in a way that suggests a tighter constraint.
+type TightenConstraintRule struct{}
+
+// Apply applies the rule to given file.
+func (*TightenConstraintRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintTightenConstraint{file, onFailure}
+	for _, decl := range file.AST.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil && fn.Type.TypeParams != nil {
+			w.checkFunc(fn)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*TightenConstraintRule) Name() string {
+	return "tighten-constraint"
+}
+
+type lintTightenConstraint struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintTightenConstraint) checkFunc(fn *ast.FuncDecl) {
+	info := w.file.Pkg.TypesInfo()
+
+	// unconstrained maps type parameters constrained by any to their declaring identifier
+	unconstrained := map[*types.TypeParam]*ast.Ident{}
+	for _, field := range fn.Type.TypeParams.List {
+		constraint := w.file.Pkg.TypeOf(field.Type)
+		if constraint == nil {
+			continue
+		}
+		iface, ok := constraint.Underlying().(*types.Interface)
+		if !ok || !iface.Empty() {
+			continue
+		}
+		for _, name := range field.Names {
+			if tn, ok := info.Defs[name].(*types.TypeName); ok {
+				if tp, ok := tn.Type().(*types.TypeParam); ok {
+					unconstrained[tp] = name
+				}
+			}
+		}
+	}
+
+	if len(unconstrained) == 0 {
+		return
+	}
+
+	// typeParamOf returns the unconstrained type parameter of the value converted to an interface by the given expression, if any
+	typeParamOf := func(expr ast.Expr) *types.TypeParam {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok || len(call.Args) != 1 || !info.Types[call.Fun].IsType() || !isInterface(w.file.Pkg.TypeOf(call.Fun)) {
+			return nil
+		}
+		tp, ok := w.file.Pkg.TypeOf(call.Args[0]).(*types.TypeParam)
+		if !ok || unconstrained[tp] == nil {
+			return nil
+		}
+		return tp
+	}
+
+	suggestions := map[*types.TypeParam]string{}
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BinaryExpr:
+			if n.Op != token.EQL && n.Op != token.NEQ {
+				return true
+			}
+			for _, operand := range []ast.Expr{n.X, n.Y} {
+				if tp := typeParamOf(operand); tp != nil && suggestions[tp] == "" {
+					suggestions[tp] = "its values are compared with " + n.Op.String() + ", use comparable"
+				}
+			}
+		case *ast.TypeSwitchStmt:
+			var subject ast.Expr
+			switch s := n.Assign.(type) {
+			case *ast.ExprStmt:
+				subject = s.X
+			case *ast.AssignStmt:
+				subject = s.Rhs[0]
+			}
+			assertion, ok := subject.(*ast.TypeAssertExpr)
+			if !ok {
+				return true
+			}
+			tp := typeParamOf(assertion.X)
+			if tp == nil || !w.onlyNumericCases(n.Body) {
+				return true
+			}
+			suggestions[tp] = "it is only switched over numeric types, use a numeric constraint like ~int | ~float64"
+		}
+		return true
+	})
+
+	for _, field := range fn.Type.TypeParams.List {
+		for _, name := range field.Names {
+			tn, ok := info.Defs[name].(*types.TypeName)
+			if !ok {
+				continue
+			}
+			tp, ok := tn.Type().(*types.TypeParam)
+			if !ok || suggestions[tp] == "" {
+				continue
+			}
+
+			w.onFailure(lint.Failure{
+				Category:   "style",
+				Confidence: 0.5,
+				Node:       name,
+				Failure:    fmt.Sprintf("type parameter %s is constrained by %s but %s", name.Name, strings.TrimSpace(gofmt(field.Type)), suggestions[tp]),
+			})
+		}
+	}
+}
+
+// onlyNumericCases returns true if all the non-default cases of a type switch are numeric types
+func (w lintTightenConstraint) onlyNumericCases(body *ast.BlockStmt) bool {
+	cases := 0
+	for _, stmt := range body.List {
+		clause, ok := stmt.(*ast.CaseClause)
+		if !ok {
+			continue
+		}
+		for _, expr := range clause.List {
+			t := w.file.Pkg.TypeOf(expr)
+			if t == nil {
+				return false
+			}
+			basic, ok := t.Underlying().(*types.Basic)
+			if !ok || basic.Info()&types.IsNumeric == 0 {
+				return false
+			}
+			cases++
+		}
+	}
+
+	return cases > 0
+}
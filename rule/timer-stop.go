@@ -0,0 +1,85 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+const requireDeferArgument = "requireDefer"
+
+// TimerStopRule spots time.Ticker and time.Timer values that are never stopped.
+type TimerStopRule struct {
+	configured   bool
+	requireDefer bool
+	sync.Mutex
+}
+
+func (r *TimerStopRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	for _, arg := range arguments {
+		flag, ok := arg.(string)
+		if !ok || flag != requireDeferArgument {
+			panic(fmt.Sprintf("Invalid argument '%v' for '%s' rule. Expecting %q", arg, r.Name(), requireDeferArgument))
+		}
+		r.requireDefer = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *TimerStopRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	stop := "Stop()"
+	if r.requireDefer {
+		stop = "a deferred Stop()"
+	}
+
+	w := lintUnclosedResource{
+		file: file,
+		isResource: func(t types.Type) bool {
+			ptr, ok := t.(*types.Pointer)
+			return ok && (isNamedType(ptr.Elem(), "time", "Ticker") || isNamedType(ptr.Elem(), "time", "Timer"))
+		},
+		method:    "Stop",
+		owner:     func(x ast.Expr) ast.Expr { return x },
+		mustDefer: r.requireDefer,
+		onUnclosed: func(node ast.Node, name string, call *ast.CallExpr) {
+			msg := fmt.Sprintf("%s is never stopped with %s, its resources might leak", name, stop)
+			if name == "" {
+				msg = fmt.Sprintf("the result of %s is discarded, it can not be stopped and its resources might leak", gofmt(call.Fun))
+			}
+			onFailure(lint.Failure{
+				Category:   "bad practice",
+				Confidence: 0.8,
+				Node:       node,
+				Failure:    msg,
+			})
+		},
+	}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*TimerStopRule) Name() string {
+	return "timer-stop"
+}
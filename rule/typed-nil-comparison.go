@@ -0,0 +1,325 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// TypedNilComparisonRule spots comparisons with nil of interface values that might hold a typed nil pointer.
+type TypedNilComparisonRule struct{}
+
+// Apply applies the rule to given file.
+func (*TypedNilComparisonRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintTypedNilComparison{
+		file:      file,
+		returners: typedNilReturners(file.Pkg),
+		onFailure: onFailure,
+	}
+	for _, decl := range file.AST.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+			w.checkBody(fn.Body)
+		}
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*TypedNilComparisonRule) Name() string {
+	return "typed-nil-comparison"
+}
+
+// typedNilReturners returns, for each function of the package, the indexes of the interface results
+// that might hold a nil pointer, along with the pointer type.
+func typedNilReturners(pkg *lint.Package) map[*types.Func]map[int]types.Type {
+	info := pkg.TypesInfo()
+	result := map[*types.Func]map[int]types.Type{}
+	for _, f := range pkg.Files() {
+		for _, decl := range f.AST.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Body == nil || fn.Type.Results == nil {
+				continue
+			}
+
+			obj, ok := info.Defs[fn.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			isInterfaceResult := map[int]bool{}
+			i := 0
+			for _, field := range fn.Type.Results.List {
+				isInterface := false
+				if t := pkg.TypeOf(field.Type); t != nil {
+					_, isInterface = t.Underlying().(*types.Interface)
+				}
+				n := len(field.Names)
+				if n == 0 {
+					n = 1
+				}
+				for ; n > 0; n-- {
+					isInterfaceResult[i] = isInterface
+					i++
+				}
+			}
+
+			nilable := nilablePointers(info, fn.Body)
+			ast.Inspect(fn.Body, func(n ast.Node) bool {
+				switch n := n.(type) {
+				case *ast.FuncLit:
+					return false // returns of function literals are not returns of the function
+				case *ast.ReturnStmt:
+					for i, expr := range n.Results {
+						if !isInterfaceResult[i] || !mayBeTypedNil(info, nilable, expr) {
+							continue
+						}
+						if result[obj] == nil {
+							result[obj] = map[int]types.Type{}
+						}
+						result[obj][i] = pkg.TypeOf(expr)
+					}
+				}
+				return true
+			})
+		}
+	}
+
+	return result
+}
+
+// mayBeTypedNil returns true if the given expression is of pointer type and it might be nil, that is if it is
+// a nil conversion (e.g. (*T)(nil)) or a local variable of the function that is left uninitialized or assigned nil on some path.
+// Other pointers (e.g. package-level variables initialized with &T{}, results of type assertions or of calls) are assumed not nil.
+func mayBeTypedNil(info *types.Info, nilable map[types.Object]bool, expr ast.Expr) bool {
+	if _, isPointer := info.TypeOf(expr).(*types.Pointer); !isPointer {
+		return false
+	}
+
+	switch e := unparen(expr).(type) {
+	case *ast.Ident:
+		return nilable[info.Uses[e]]
+	case *ast.CallExpr:
+		return isNilConversion(info, e)
+	}
+
+	return false
+}
+
+// isNilConversion returns true if the expression converts nil to a type (e.g. (*T)(nil))
+func isNilConversion(info *types.Info, expr ast.Expr) bool {
+	call, ok := unparen(expr).(*ast.CallExpr)
+	if !ok || len(call.Args) != 1 || !isIdent(unparen(call.Args[0]), "nil") {
+		return false
+	}
+
+	tv, ok := info.Types[call.Fun]
+	return ok && tv.IsType()
+}
+
+// nilablePointers returns the local pointer variables of the function body that might be nil:
+// those declared without a value and those assigned nil
+func nilablePointers(info *types.Info, body *ast.BlockStmt) map[types.Object]bool {
+	result := map[types.Object]bool{}
+	mark := func(id *ast.Ident) {
+		obj := info.ObjectOf(id)
+		if obj == nil {
+			return
+		}
+		if _, isPointer := obj.Type().(*types.Pointer); isPointer {
+			result[obj] = true
+		}
+	}
+	isNil := func(expr ast.Expr) bool {
+		return isIdent(unparen(expr), "nil") || isNilConversion(info, expr)
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			for i, name := range n.Names {
+				if len(n.Values) == 0 || (len(n.Values) == len(n.Names) && isNil(n.Values[i])) {
+					mark(name)
+				}
+			}
+		case *ast.AssignStmt:
+			if len(n.Lhs) != len(n.Rhs) {
+				return true
+			}
+			for i, lhs := range n.Lhs {
+				if id, ok := lhs.(*ast.Ident); ok && isNil(n.Rhs[i]) {
+					mark(id)
+				}
+			}
+		}
+		return true
+	})
+
+	return result
+}
+
+type lintTypedNilComparison struct {
+	file      *lint.File
+	returners map[*types.Func]map[int]types.Type
+	onFailure func(lint.Failure)
+}
+
+// typedNilSource describes where a possibly typed nil interface value comes from
+type typedNilSource struct {
+	origin      string
+	pointerType types.Type
+}
+
+func (w lintTypedNilComparison) checkBody(body *ast.BlockStmt) {
+	info := w.file.Pkg.TypesInfo()
+	nilable := nilablePointers(info, body)
+	suspicious := map[types.Object]typedNilSource{}
+
+	assign := func(lhs ast.Expr, source *typedNilSource) {
+		id, ok := lhs.(*ast.Ident)
+		if !ok || isBlank(id) {
+			return
+		}
+		obj := info.ObjectOf(id)
+		if obj == nil {
+			return
+		}
+		if source == nil {
+			delete(suspicious, obj)
+			return
+		}
+		suspicious[obj] = *source
+	}
+
+	assignAll := func(lhs []ast.Expr, rhs []ast.Expr) {
+		if len(rhs) == 1 && len(lhs) > 1 {
+			call, ok := rhs[0].(*ast.CallExpr)
+			for i, l := range lhs {
+				var source *typedNilSource
+				if ok {
+					source = w.callSource(call, i)
+				}
+				assign(l, source)
+			}
+			return
+		}
+
+		for i, l := range lhs {
+			if i >= len(rhs) {
+				break
+			}
+			assign(l, w.exprSource(nilable, l, rhs[i]))
+		}
+	}
+
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.AssignStmt:
+			assignAll(n.Lhs, n.Rhs)
+		case *ast.ValueSpec:
+			lhs := make([]ast.Expr, len(n.Names))
+			for i, name := range n.Names {
+				lhs[i] = name
+			}
+			assignAll(lhs, n.Values)
+		case *ast.BinaryExpr:
+			if n.Op != token.EQL && n.Op != token.NEQ {
+				return true
+			}
+
+			var other ast.Expr
+			switch {
+			case isIdent(n.Y, "nil"):
+				other = n.X
+			case isIdent(n.X, "nil"):
+				other = n.Y
+			default:
+				return true
+			}
+
+			var source *typedNilSource
+			switch o := other.(type) {
+			case *ast.Ident:
+				if s, ok := suspicious[info.Uses[o]]; ok {
+					source = &s
+				}
+			case *ast.CallExpr:
+				source = w.callSource(o, 0)
+			}
+
+			if source != nil {
+				w.report(n, other, *source)
+			}
+		}
+		return true
+	})
+}
+
+// exprSource returns the source of the typed nil the given expression might evaluate to, if any
+func (w lintTypedNilComparison) exprSource(nilable map[types.Object]bool, lhs, rhs ast.Expr) *typedNilSource {
+	if call, ok := rhs.(*ast.CallExpr); ok {
+		if source := w.callSource(call, 0); source != nil {
+			return source
+		}
+	}
+
+	// var err error = p, with p a pointer
+	lt := w.file.Pkg.TypeOf(lhs)
+	if lt == nil {
+		return nil
+	}
+	if _, isInterface := lt.Underlying().(*types.Interface); !isInterface {
+		return nil
+	}
+	if mayBeTypedNil(w.file.Pkg.TypesInfo(), nilable, rhs) {
+		return &typedNilSource{origin: gofmt(rhs), pointerType: w.file.Pkg.TypeOf(rhs)}
+	}
+
+	return nil
+}
+
+// callSource returns the source of the typed nil the i-th result of the given call might hold, if any
+func (w lintTypedNilComparison) callSource(call *ast.CallExpr, i int) *typedNilSource {
+	var id *ast.Ident
+	switch fn := call.Fun.(type) {
+	case *ast.Ident:
+		id = fn
+	case *ast.SelectorExpr:
+		id = fn.Sel
+	default:
+		return nil
+	}
+
+	fn, ok := w.file.Pkg.TypesInfo().Uses[id].(*types.Func)
+	if !ok {
+		return nil
+	}
+
+	t, ok := w.returners[fn.Origin()][i]
+	if !ok {
+		return nil
+	}
+
+	return &typedNilSource{origin: gofmt(call.Fun), pointerType: t}
+}
+
+func (w lintTypedNilComparison) report(node ast.Node, value ast.Expr, source typedNilSource) {
+	typ := types.TypeString(source.pointerType, types.RelativeTo(w.file.Pkg.TypesPkg()))
+	w.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 0.8,
+		Node:       node,
+		Failure:    fmt.Sprintf("%s might hold a nil %s coming from %s, an interface holding a nil pointer is not equal to nil", gofmt(value), typ, source.origin),
+	})
+}
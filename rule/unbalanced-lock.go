@@ -0,0 +1,362 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"sort"
+	"strings"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UnbalancedLockRule spots execution paths that return with a mutex still locked.
+type UnbalancedLockRule struct{}
+
+// Apply applies the rule to given file.
+func (*UnbalancedLockRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintUnbalancedLock{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UnbalancedLockRule) Name() string {
+	return "unbalanced-lock"
+}
+
+type lintUnbalancedLock struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintUnbalancedLock) Visit(node ast.Node) ast.Visitor {
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if name := strings.ToLower(n.Name.Name); strings.HasPrefix(name, "lock") || strings.HasPrefix(name, "rlock") {
+			return w // a locking helper (e.g. func (c *conn) lock()), it is meant to return with the mutex locked
+		}
+		body = n.Body
+	case *ast.FuncLit:
+		body = n.Body
+	default:
+		return w
+	}
+
+	if body == nil || w.isLockWrapper(body) {
+		return w
+	}
+
+	a := &lockAnalyzer{file: w.file, onFailure: w.onFailure}
+	out := a.block(body.List, newLockState())
+	if out != nil {
+		a.check(out, lint.ToFailurePosition(body.Rbrace, body.Rbrace, w.file), "at the end of the function", unlockAdvice)
+	}
+
+	return w
+}
+
+// isLockWrapper returns true if the given body is a single Lock (or Unlock) call, as in func (c *conn) acquire() { c.mu.Lock() }
+func (w lintUnbalancedLock) isLockWrapper(body *ast.BlockStmt) bool {
+	if len(body.List) != 1 {
+		return false
+	}
+
+	_, method, ok := syncMethodCallStmt(w.file.Pkg, body.List[0])
+	_, isLockMethod := lockKeys[method]
+	return ok && isLockMethod
+}
+
+// lockState represents the locks held (and the deferred unlocks) at a given point of a function.
+type lockState struct {
+	held     map[string]string // lock key -> mutex
+	deferred map[string]bool   // lock keys released by deferred calls
+	// callerHeld are the lock keys unlocked before being locked, thus held by the caller of the function
+	// (e.g. sync.Cond.Wait unlocks, then locks again, the mutex of the caller)
+	callerHeld map[string]bool
+}
+
+func newLockState() *lockState {
+	return &lockState{held: map[string]string{}, deferred: map[string]bool{}, callerHeld: map[string]bool{}}
+}
+
+func (s *lockState) copy() *lockState {
+	result := newLockState()
+	for k, v := range s.held {
+		result.held[k] = v
+	}
+	for k := range s.deferred {
+		result.deferred[k] = true
+	}
+	for k := range s.callerHeld {
+		result.callerHeld[k] = true
+	}
+	return result
+}
+
+// mergeLockStates merges the states of the given (converging) paths.
+// Locks are kept only if held in all paths to avoid false positives on conditional locking.
+func mergeLockStates(states []*lockState) *lockState {
+	var result *lockState
+	for _, s := range states {
+		if s == nil {
+			continue // terminated path
+		}
+		if result == nil {
+			result = s.copy()
+			continue
+		}
+		for k := range result.held {
+			if _, ok := s.held[k]; !ok {
+				delete(result.held, k)
+			}
+		}
+		for k := range s.deferred {
+			result.deferred[k] = true
+		}
+		for k := range s.callerHeld {
+			result.callerHeld[k] = true
+		}
+	}
+	return result
+}
+
+// lockKeys maps locking and unlocking methods to the kind of lock they handle
+var lockKeys = map[string]string{
+	"Lock":    "w",
+	"Unlock":  "w",
+	"RLock":   "r",
+	"RUnlock": "r",
+}
+
+type lockAnalyzer struct {
+	file *lint.File
+	// onFailure, if set, is called with the failures of the paths leaving the function with a mutex still locked
+	onFailure func(lint.Failure)
+	// onLock, if set, is called for each lock call with the state preceding it
+	onLock func(call *ast.CallExpr, mutex, method string, state *lockState)
+}
+
+// block analyzes a list of statements and returns the resulting state, nil if all paths terminate.
+func (a *lockAnalyzer) block(stmts []ast.Stmt, state *lockState) *lockState {
+	for _, stmt := range stmts {
+		state = a.stmt(stmt, state)
+		if state == nil {
+			return nil
+		}
+	}
+	return state
+}
+
+func (a *lockAnalyzer) stmt(stmt ast.Stmt, state *lockState) *lockState {
+	switch s := stmt.(type) {
+	case *ast.BlockStmt:
+		return a.block(s.List, state)
+	case *ast.LabeledStmt:
+		return a.stmt(s.Stmt, state)
+	case *ast.ReturnStmt:
+		a.apply(s, state)
+		a.check(state, lint.ToFailurePosition(s.Pos(), s.End(), a.file), "when returning", unlockAdvice)
+		return nil
+	case *ast.BranchStmt:
+		return nil // the state at the branch target is not tracked
+	case *ast.ExprStmt:
+		if a.isTerminatingCall(s.X) {
+			return nil
+		}
+		a.apply(s, state)
+	case *ast.DeferStmt:
+		a.deferred(s.Call, state)
+	case *ast.GoStmt:
+		// the goroutine does not affect the locks of the current function
+	case *ast.IfStmt:
+		a.apply(s.Init, state)
+		a.apply(s.Cond, state)
+		then := a.block(s.Body.List, state.copy())
+		other := state.copy()
+		if s.Else != nil {
+			other = a.stmt(s.Else, other)
+		}
+		return mergeLockStates([]*lockState{then, other})
+	case *ast.ForStmt:
+		a.apply(s.Init, state)
+		a.apply(s.Cond, state)
+		return a.loop(s.Body, state)
+	case *ast.RangeStmt:
+		a.apply(s.X, state)
+		return a.loop(s.Body, state)
+	case *ast.SwitchStmt:
+		a.apply(s.Init, state)
+		a.apply(s.Tag, state)
+		return a.clauses(s.Body, state, true)
+	case *ast.TypeSwitchStmt:
+		a.apply(s.Init, state)
+		return a.clauses(s.Body, state, true)
+	case *ast.SelectStmt:
+		return a.clauses(s.Body, state, false)
+	default:
+		a.apply(s, state)
+	}
+
+	return state
+}
+
+// loop analyzes the body of a loop and returns the state after the loop.
+// Mutexes locked by the body and still locked at the end of an iteration are reported.
+func (a *lockAnalyzer) loop(body *ast.BlockStmt, state *lockState) *lockState {
+	out := a.block(body.List, state.copy())
+	if out == nil {
+		return state
+	}
+
+	leaked := newLockState()
+	for key, mutex := range out.held {
+		if _, heldBefore := state.held[key]; !heldBefore && !out.callerHeld[key] {
+			leaked.held[key] = mutex
+		}
+	}
+	a.check(leaked, lint.ToFailurePosition(body.Rbrace, body.Rbrace, a.file), "at the end of the loop iteration", "unlock it before the next iteration")
+
+	// the body might not be executed
+	return mergeLockStates([]*lockState{state, out})
+}
+
+// clauses analyzes the clauses of switch and select statements.
+func (a *lockAnalyzer) clauses(body *ast.BlockStmt, state *lockState, isSwitch bool) *lockState {
+	var states []*lockState
+	hasDefault := false
+	for _, clause := range body.List {
+		var stmts []ast.Stmt
+		switch c := clause.(type) {
+		case *ast.CaseClause:
+			hasDefault = hasDefault || c.List == nil
+			stmts = c.Body
+		case *ast.CommClause:
+			hasDefault = hasDefault || c.Comm == nil
+			stmts = c.Body
+			if c.Comm != nil {
+				stmts = append([]ast.Stmt{c.Comm}, stmts...)
+			}
+		}
+		states = append(states, a.block(stmts, state.copy()))
+	}
+
+	if isSwitch && !hasDefault {
+		states = append(states, state)
+	}
+
+	return mergeLockStates(states)
+}
+
+// apply updates the state with the lock and unlock calls of the given node.
+func (a *lockAnalyzer) apply(node ast.Node, state *lockState) {
+	if node == nil {
+		return
+	}
+
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.CallExpr:
+			mutex, method, ok := syncMethodCall(a.file.Pkg, n)
+			kind, isLockMethod := lockKeys[method]
+			if !ok || !isLockMethod {
+				return true
+			}
+
+			key := mutex + "#" + kind
+			if a.onLock != nil && !strings.HasSuffix(method, "Unlock") {
+				a.onLock(n, mutex, method, state)
+			}
+			if strings.HasSuffix(method, "Unlock") {
+				if _, held := state.held[key]; !held {
+					state.callerHeld[key] = true
+				}
+				delete(state.held, key)
+			} else {
+				state.held[key] = mutex
+			}
+		}
+		return true
+	})
+}
+
+// deferred registers the unlock calls of the given deferred call.
+func (a *lockAnalyzer) deferred(call *ast.CallExpr, state *lockState) {
+	unlocks := []ast.Node{call}
+	if lit, ok := call.Fun.(*ast.FuncLit); ok {
+		unlocks = pick(lit.Body, func(n ast.Node) bool {
+			_, ok := n.(*ast.CallExpr)
+			return ok
+		})
+	}
+
+	for _, n := range unlocks {
+		mutex, method, ok := syncMethodCall(a.file.Pkg, n.(*ast.CallExpr))
+		if ok && strings.HasSuffix(method, "Unlock") {
+			state.deferred[mutex+"#"+lockKeys[method]] = true
+		}
+	}
+}
+
+const unlockAdvice = "unlock it or defer its unlocking"
+
+func (a *lockAnalyzer) check(state *lockState, position lint.FailurePosition, where, advice string) {
+	if a.onFailure == nil {
+		return
+	}
+
+	var locked []string
+	for key, mutex := range state.held {
+		if !state.deferred[key] && !state.callerHeld[key] {
+			locked = append(locked, mutex)
+		}
+	}
+
+	if len(locked) == 0 {
+		return
+	}
+
+	sort.Strings(locked)
+	a.onFailure(lint.Failure{
+		Category:   "logic",
+		Confidence: 0.8,
+		Position:   position,
+		Failure:    fmt.Sprintf("%s still locked %s, %s", strings.Join(locked, ", "), where, advice),
+	})
+}
+
+func (*lockAnalyzer) isTerminatingCall(expr ast.Expr) bool {
+	call, ok := expr.(*ast.CallExpr)
+	if !ok {
+		return false
+	}
+
+	if isIdent(call.Fun, "panic") {
+		return true
+	}
+
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	switch {
+	case isIdent(sel.X, "os") && sel.Sel.Name == "Exit":
+		return true
+	case isIdent(sel.X, "log") && (strings.HasPrefix(sel.Sel.Name, "Fatal") || strings.HasPrefix(sel.Sel.Name, "Panic")):
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,134 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UndifferentiatedErrorsRule spots functions returning the same bare error from many places.
+type UndifferentiatedErrorsRule struct {
+	threshold int
+	sync.Mutex
+}
+
+const defaultUndifferentiatedErrorsThreshold = 3
+
+func (r *UndifferentiatedErrorsRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+	if r.threshold == 0 {
+		if len(arguments) < 1 {
+			r.threshold = defaultUndifferentiatedErrorsThreshold
+			return
+		}
+		threshold, ok := arguments[0].(int64)
+		if !ok {
+			panic(fmt.Sprintf(`invalid value passed as threshold to the "undifferentiated-errors" rule; need int64 but got %T`, arguments[0]))
+		}
+		if threshold < 2 {
+			panic(`the value passed as threshold to the "undifferentiated-errors" rule must be greater than 1`)
+		}
+		r.threshold = int(threshold)
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *UndifferentiatedErrorsRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintUndifferentiatedErrors{file: file, threshold: r.threshold, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UndifferentiatedErrorsRule) Name() string {
+	return "undifferentiated-errors"
+}
+
+type lintUndifferentiatedErrors struct {
+	file      *lint.File
+	threshold int
+	onFailure func(lint.Failure)
+}
+
+func (w lintUndifferentiatedErrors) Visit(node ast.Node) ast.Visitor {
+	var name string
+	var body *ast.BlockStmt
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		name, body = "function "+n.Name.Name, n.Body
+	case *ast.FuncLit:
+		name, body = "function literal", n.Body
+	default:
+		return w
+	}
+
+	if body == nil {
+		return w
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	// counts are by name rather than by object because errors declared in the
+	// scope of if statements (if err := f(); err != nil {...}) are distinct objects
+	counts := map[string]int{}
+	var order []*ast.Ident
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncLit:
+			return false // will be analyzed on its own
+		case *ast.ReturnStmt:
+			if len(n.Results) == 0 {
+				return true
+			}
+
+			id, ok := n.Results[len(n.Results)-1].(*ast.Ident)
+			if !ok || id.Name == "nil" {
+				return true
+			}
+
+			obj := info.Uses[id]
+			if obj == nil || !implementsError(obj.Type()) || isPackageLevel(obj) {
+				return true // sentinel errors are meant to be returned as they are
+			}
+
+			if counts[id.Name] == 0 {
+				order = append(order, id)
+			}
+			counts[id.Name]++
+		}
+		return true
+	})
+
+	for _, id := range order {
+		count := counts[id.Name]
+		if count < w.threshold {
+			continue
+		}
+
+		w.onFailure(lint.Failure{
+			Category:   "errors",
+			Confidence: 0.8,
+			Node:       node,
+			Failure:    fmt.Sprintf("%s returns the unwrapped error %s from %d different places, consider adding context to differentiate them", name, id.Name, count),
+		})
+	}
+
+	return w
+}
+
+func isPackageLevel(obj types.Object) bool {
+	return obj.Pkg() != nil && obj.Parent() == obj.Pkg().Scope()
+}
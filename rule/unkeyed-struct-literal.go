@@ -0,0 +1,139 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"regexp"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UnkeyedStructLiteralRule spots struct literals whose fields are not named.
+type UnkeyedStructLiteralRule struct {
+	configured          bool
+	allowTypes          *regexp.Regexp
+	minFields           int
+	ignoreImportedTypes bool
+	sync.Mutex
+}
+
+func (r *UnkeyedStructLiteralRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		switch k {
+		case "allowTypes":
+			pattern, ok := v.(string)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting allowTypes to be a regular expression, got %T", r.Name(), v))
+			}
+			re, err := regexp.Compile(pattern)
+			if err != nil {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Regular expression %q of allowTypes does not compile: %v", r.Name(), pattern, err))
+			}
+			r.allowTypes = re
+		case "minFields":
+			minFields, ok := v.(int64)
+			if !ok || minFields < 0 {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting minFields to be a non-negative integer, got %v", r.Name(), v))
+			}
+			r.minFields = int(minFields)
+		case "ignoreImportedTypes":
+			r.ignoreImportedTypes, ok = v.(bool)
+			if !ok {
+				panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting ignoreImportedTypes to be a boolean, got %T", r.Name(), v))
+			}
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *UnkeyedStructLiteralRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	file.Pkg.TypeCheck()
+	info := file.Pkg.TypesInfo()
+	if info == nil {
+		return nil
+	}
+	pkg := file.Pkg.TypesPkg()
+
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		lit, ok := n.(*ast.CompositeLit)
+		if !ok || len(lit.Elts) == 0 {
+			return true
+		}
+		if _, isKeyed := lit.Elts[0].(*ast.KeyValueExpr); isKeyed {
+			return true
+		}
+
+		typeName, st := r.literalType(info, lit, pkg)
+		if st == nil || st.NumFields() < r.minFields {
+			return true
+		}
+		if r.allowTypes != nil && r.allowTypes.MatchString(typeName) {
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "bad practice",
+			Confidence: 1,
+			Node:       lit,
+			Failure:    fmt.Sprintf("literal of the struct %s has unkeyed fields, name them (e.g. %s: ...) to not depend on the order of the fields", typeName, st.Field(0).Name()),
+		})
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UnkeyedStructLiteralRule) Name() string {
+	return "unkeyed-struct-literal"
+}
+
+// literalType returns the name and the struct type of the literal, or a nil struct type if the literal is not of a checked named struct type.
+// Names of types of other packages are qualified with the name of their package (e.g. image.Point).
+func (r *UnkeyedStructLiteralRule) literalType(info *types.Info, lit *ast.CompositeLit, pkg *types.Package) (string, *types.Struct) {
+	t := info.TypeOf(lit)
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem() // elided &T in literals of []*T
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return "", nil // literals of anonymous structs are declared alongside their type
+	}
+	st, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return "", nil
+	}
+
+	obj := named.Obj()
+	if obj.Pkg() == nil || obj.Pkg() == pkg {
+		return obj.Name(), st
+	}
+	if r.ignoreImportedTypes {
+		return "", nil
+	}
+	return obj.Pkg().Name() + "." + obj.Name(), st
+}
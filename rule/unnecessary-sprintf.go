@@ -0,0 +1,183 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UnnecessarySprintfRule spots calls to fmt.Sprintf that only concatenate strings.
+type UnnecessarySprintfRule struct {
+	allowSingleVerb bool
+	checkStringers  bool
+	configured      bool
+	sync.Mutex
+}
+
+func (r *UnnecessarySprintfRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		return
+	}
+
+	args, ok := arguments[0].(map[string]any)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a k,v map, got %T", r.Name(), arguments[0]))
+	}
+	for k, v := range args {
+		value, ok := v.(bool)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting %s to be a boolean, got %T", r.Name(), k, v))
+		}
+		switch k {
+		case "allowSingleVerb":
+			r.allowSingleVerb = value
+		case "checkStringers":
+			r.checkStringers = value
+		default:
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Unknown argument %s", r.Name(), k))
+		}
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *UnnecessarySprintfRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	file.Pkg.TypeCheck()
+	if file.Pkg.TypesPkg() == nil {
+		return nil
+	}
+	info := file.Pkg.TypesInfo()
+
+	var failures []lint.Failure
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok || len(call.Args) < 2 || call.Ellipsis.IsValid() {
+			return true
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Sprintf" {
+			return true
+		}
+		fn, ok := info.Uses[sel.Sel].(*types.Func)
+		if !ok || fn.Pkg() == nil || fn.Pkg().Path() != "fmt" {
+			return true
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return true
+		}
+		format, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return true
+		}
+
+		// the format must be made of %s verbs only, separated by plain text
+		texts := strings.Split(format, "%s")
+		args := call.Args[1:]
+		if len(texts)-1 != len(args) || strings.Contains(format, "%%") {
+			return true
+		}
+		for _, text := range texts {
+			if strings.Contains(text, "%") {
+				return true
+			}
+		}
+
+		if r.allowSingleVerb && len(args) == 1 {
+			return true
+		}
+
+		var operands []string
+		for i, arg := range args {
+			if texts[i] != "" {
+				operands = append(operands, strconv.Quote(texts[i]))
+			}
+
+			operand, ok := r.stringOperand(file.Pkg.TypeOf(arg), arg)
+			if !ok {
+				return true
+			}
+			operands = append(operands, operand)
+		}
+		if last := texts[len(texts)-1]; last != "" {
+			operands = append(operands, strconv.Quote(last))
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "style",
+			Confidence: 0.8,
+			Node:       call,
+			Failure:    fmt.Sprintf("fmt.Sprintf only concatenates strings, replace it with %s", strings.Join(operands, " + ")),
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UnnecessarySprintfRule) Name() string {
+	return "unnecessary-sprintf"
+}
+
+// stringOperand returns the string expression formatted by %s for the given argument,
+// if it is a string or, when configured, a fmt.Stringer
+func (r *UnnecessarySprintfRule) stringOperand(t types.Type, arg ast.Expr) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+
+	// %s formats errors and fmt.Stringers (even of string types) with their methods
+	if implementsError(t) {
+		return "", false
+	}
+	if isStringer(t) {
+		if !r.checkStringers {
+			return "", false
+		}
+		return gofmt(arg) + ".String()", true
+	}
+
+	basic, ok := t.Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsString == 0 {
+		return "", false
+	}
+	if _, isNamed := t.(*types.Named); !isNamed {
+		return gofmt(arg), true
+	}
+	return "string(" + gofmt(arg) + ")", true // a named string type
+}
+
+// isStringer returns true if values of the given type have a String() string method
+func isStringer(t types.Type) bool {
+	obj, _, _ := types.LookupFieldOrMethod(t, false, nil, "String")
+	fn, ok := obj.(*types.Func)
+	if !ok {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Params().Len() != 0 || sig.Results().Len() != 1 {
+		return false
+	}
+	result, ok := sig.Results().At(0).Type().(*types.Basic)
+	return ok && result.Kind() == types.String
+}
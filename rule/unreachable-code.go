@@ -2,6 +2,7 @@ package rule
 
 import (
 	"go/ast"
+	"go/token"
 
 	"github.com/mgechev/revive/lint"
 )
@@ -70,12 +71,17 @@ loop:
 
 		switch s := stmt.(type) {
 		case *ast.ReturnStmt:
-			w.onFailure(newUnreachableCodeFailure(s))
+			w.onFailure(newUnreachableCodeFailure(s, next))
 			break loop
 		case *ast.BranchStmt:
 			token := s.Tok.String()
 			if token != "fallthrough" {
-				w.onFailure(newUnreachableCodeFailure(s))
+				w.onFailure(newUnreachableCodeFailure(s, next))
+				break loop
+			}
+		case *ast.ForStmt, *ast.LabeledStmt, *ast.SelectStmt:
+			if isEndless(s) {
+				w.onFailure(newUnreachableCodeFailure(s, next))
 				break loop
 			}
 		case *ast.ExprStmt:
@@ -83,6 +89,10 @@ loop:
 			if !ok {
 				continue
 			}
+			if id, ok := ce.Fun.(*ast.Ident); ok && id.Name == "panic" {
+				w.onFailure(newUnreachableCodeFailure(s, next))
+				break loop
+			}
 			// it's a function call
 			fc, ok := ce.Fun.(*ast.SelectorExpr)
 			if !ok {
@@ -104,7 +114,7 @@ loop:
 				continue
 			}
 
-			w.onFailure(newUnreachableCodeFailure(s))
+			w.onFailure(newUnreachableCodeFailure(s, next))
 			break loop
 		}
 	}
@@ -112,11 +122,63 @@ loop:
 	return w
 }
 
-func newUnreachableCodeFailure(node ast.Node) lint.Failure {
+// isEndless returns true if the statement is a loop without condition nor break, or an empty select
+func isEndless(stmt ast.Stmt) bool {
+	label := ""
+	if labeled, ok := stmt.(*ast.LabeledStmt); ok {
+		label = labeled.Label.Name
+		stmt = labeled.Stmt
+	}
+
+	switch s := stmt.(type) {
+	case *ast.SelectStmt:
+		return len(s.Body.List) == 0
+	case *ast.ForStmt:
+		return s.Cond == nil && !hasBreak(s.Body, label)
+	default:
+		return false
+	}
+}
+
+// hasBreak returns true if the body of a loop contains a break statement exiting the loop
+func hasBreak(body *ast.BlockStmt, label string) bool {
+	found := false
+	var inspect func(node ast.Node, nested bool)
+	inspect = func(node ast.Node, nested bool) {
+		ast.Inspect(node, func(n ast.Node) bool {
+			if found {
+				return false
+			}
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false
+			case *ast.ForStmt, *ast.RangeStmt, *ast.SwitchStmt, *ast.TypeSwitchStmt, *ast.SelectStmt:
+				if n != node {
+					inspect(n, true) // unlabeled breaks exit the inner statement
+					return false
+				}
+			case *ast.BranchStmt:
+				if n.Tok == token.BREAK && ((n.Label == nil && !nested) || (n.Label != nil && n.Label.Name == label)) {
+					found = true
+				}
+			}
+			return true
+		})
+	}
+	inspect(body, false)
+
+	return found
+}
+
+func newUnreachableCodeFailure(node, unreachable ast.Node) lint.Failure {
 	return lint.Failure{
 		Confidence: 1,
 		Node:       node,
 		Category:   "logic",
 		Failure:    "unreachable code after this statement",
+		RelatedInformation: []lint.RelatedInformation{{
+			Node:    unreachable,
+			Message: "first unreachable statement",
+		}},
 	}
 }
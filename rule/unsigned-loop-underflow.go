@@ -0,0 +1,94 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UnsignedLoopUnderflowRule spots loops decrementing an unsigned counter while it is >= 0.
+type UnsignedLoopUnderflowRule struct{}
+
+// Apply applies the rule to given file.
+func (*UnsignedLoopUnderflowRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		loop, ok := n.(*ast.ForStmt)
+		if !ok || loop.Cond == nil || loop.Post == nil {
+			return true
+		}
+
+		counter := nonNegativeCheckOperand(file, loop.Cond)
+		if counter == nil || !isDecrementOf(loop.Post, counter) {
+			return true
+		}
+
+		basic, ok := file.Pkg.TypeOf(counter).Underlying().(*types.Basic)
+		if !ok || basic.Info()&types.IsUnsigned == 0 {
+			return true
+		}
+
+		failures = append(failures, lint.Failure{
+			Category:   "logic",
+			Confidence: 1,
+			Node:       loop,
+			Failure:    fmt.Sprintf("%s is unsigned thus %s is always true and this loop never ends, %s wraps around instead of becoming negative", counter.Name, gofmt(loop.Cond), counter.Name),
+		})
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UnsignedLoopUnderflowRule) Name() string {
+	return "unsigned-loop-underflow"
+}
+
+// nonNegativeCheckOperand returns the identifier of conditions of the form x >= 0 (or 0 <= x)
+func nonNegativeCheckOperand(file *lint.File, cond ast.Expr) *ast.Ident {
+	binary, ok := cond.(*ast.BinaryExpr)
+	if !ok {
+		return nil
+	}
+
+	operand, zero := binary.X, binary.Y
+	switch binary.Op {
+	case token.GEQ:
+	case token.LEQ:
+		operand, zero = zero, operand
+	default:
+		return nil
+	}
+
+	lit, ok := zero.(*ast.BasicLit)
+	if !ok || lit.Kind != token.INT || lit.Value != "0" {
+		return nil
+	}
+
+	id, ok := operand.(*ast.Ident)
+	if !ok || file.Pkg.TypeOf(id) == nil {
+		return nil
+	}
+
+	return id
+}
+
+// isDecrementOf returns true if the statement is x-- or x -= ...
+func isDecrementOf(stmt ast.Stmt, id *ast.Ident) bool {
+	switch s := stmt.(type) {
+	case *ast.IncDecStmt:
+		return s.Tok == token.DEC && isIdent(s.X, id.Name)
+	case *ast.AssignStmt:
+		return s.Tok == token.SUB_ASSIGN && len(s.Lhs) == 1 && isIdent(s.Lhs[0], id.Name)
+	}
+
+	return false
+}
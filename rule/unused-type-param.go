@@ -0,0 +1,85 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// UnusedTypeParamRule spots type parameters that are never used.
+type UnusedTypeParamRule struct{}
+
+// Apply applies the rule to given file.
+func (*UnusedTypeParamRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintUnusedTypeParam{file, onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UnusedTypeParamRule) Name() string {
+	return "unused-type-param"
+}
+
+type lintUnusedTypeParam struct {
+	file      *lint.File
+	onFailure func(lint.Failure)
+}
+
+func (w lintUnusedTypeParam) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		// type parameters of method receivers are mandatory, only functions declare their own
+		if n.Recv == nil {
+			w.check("function", n.Name.Name, n.Type.TypeParams, n)
+		}
+	case *ast.TypeSpec:
+		w.check("type", n.Name.Name, n.TypeParams, n)
+	}
+
+	return w
+}
+
+func (w lintUnusedTypeParam) check(kind, name string, typeParams *ast.FieldList, decl ast.Node) {
+	if typeParams == nil {
+		return
+	}
+
+	info := w.file.Pkg.TypesInfo()
+	used := map[types.Object]bool{}
+	ast.Inspect(decl, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok {
+			if obj := info.Uses[id]; obj != nil {
+				used[obj] = true
+			}
+		}
+		return true
+	})
+
+	for _, field := range typeParams.List {
+		for _, param := range field.Names {
+			obj := info.Defs[param]
+			if isBlank(param) || obj == nil || used[obj] {
+				continue
+			}
+
+			w.onFailure(lint.Failure{
+				Category:   "unused-code",
+				Confidence: 1,
+				Node:       param,
+				Failure:    fmt.Sprintf("type parameter %s of %s %s is never used, remove it", param.Name, kind, name),
+			})
+		}
+	}
+}
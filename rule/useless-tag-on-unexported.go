@@ -0,0 +1,79 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/structtag"
+	"github.com/mgechev/revive/lint"
+)
+
+// UselessTagOnUnexportedRule spots unexported struct fields tagged for encoders ignoring them.
+type UselessTagOnUnexportedRule struct{}
+
+// Apply applies the rule to given file.
+func (*UselessTagOnUnexportedRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	ast.Inspect(file.AST, func(n ast.Node) bool {
+		st, ok := n.(*ast.StructType)
+		if !ok || st.Fields == nil {
+			return true
+		}
+
+		for _, field := range st.Fields.List {
+			// embedded fields are skipped because encoders promote the exported fields of embedded unexported structs
+			if field.Tag == nil || len(field.Names) == 0 || field.Names[0].IsExported() {
+				continue
+			}
+
+			keys := encoderTagKeys(field.Tag)
+			if len(keys) == 0 {
+				continue
+			}
+
+			failures = append(failures, lint.Failure{
+				Category:   "bad practice",
+				Confidence: 1,
+				Node:       field,
+				Failure:    fmt.Sprintf("field %s is unexported, thus encoders ignore its %s tag; export the field or remove the tag", field.Names[0].Name, strings.Join(keys, "/")),
+			})
+		}
+
+		return true
+	})
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*UselessTagOnUnexportedRule) Name() string {
+	return "useless-tag-on-unexported"
+}
+
+// encoderTagKeys returns the json, xml and yaml keys of the given tag, keys explicitly ignoring the field ("-") are omitted
+func encoderTagKeys(tag *ast.BasicLit) []string {
+	value, err := strconv.Unquote(tag.Value)
+	if err != nil {
+		return nil
+	}
+
+	tags, err := structtag.Parse(value)
+	if err != nil {
+		return nil // malformed tags are reported by struct-tag
+	}
+
+	var keys []string
+	for _, t := range tags.Tags() {
+		switch t.Key {
+		case keyJSON, keyXML, keyYAML:
+			if t.Name != "-" {
+				keys = append(keys, t.Key)
+			}
+		}
+	}
+
+	return keys
+}
@@ -171,3 +171,64 @@ var directiveCommentRE = regexp.MustCompile("//(line |extern |export |[a-z0-9]+:
 func isDirectiveComment(line string) bool {
 	return directiveCommentRE.MatchString(line)
 }
+
+var errorType = types.Universe.Lookup("error").Type().Underlying().(*types.Interface)
+
+// implementsError returns true if the given type implements the error interface
+func implementsError(t types.Type) bool {
+	return t != nil && types.Implements(t, errorType)
+}
+
+// isStringLiteral returns true if the given expression is a string literal
+func isStringLiteral(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	return ok && lit.Kind == token.STRING
+}
+
+// inspectLoops traverses the node as ast.Inspect does, telling f whether the visited node is evaluated at each iteration
+// of an enclosing loop (the condition, post statement and body of a for loop, the body of a range loop).
+// Function literals are not considered as within the loops enclosing them since they might be called once.
+func inspectLoops(node ast.Node, f func(n ast.Node, inLoop bool) bool) {
+	ast.Walk(loopInspector{f: f}, node)
+}
+
+type loopInspector struct {
+	inLoop bool
+	f      func(n ast.Node, inLoop bool) bool
+}
+
+func (v loopInspector) Visit(node ast.Node) ast.Visitor {
+	if node == nil || !v.f(node, v.inLoop) {
+		return nil
+	}
+
+	inLoop := loopInspector{inLoop: true, f: v.f}
+	switch n := node.(type) {
+	case *ast.FuncLit:
+		return loopInspector{f: v.f}
+	case *ast.ForStmt:
+		if n.Init != nil {
+			ast.Walk(v, n.Init)
+		}
+		if n.Cond != nil {
+			ast.Walk(inLoop, n.Cond)
+		}
+		if n.Post != nil {
+			ast.Walk(inLoop, n.Post)
+		}
+		ast.Walk(inLoop, n.Body)
+		return nil
+	case *ast.RangeStmt:
+		if n.Key != nil {
+			ast.Walk(inLoop, n.Key) // assigned at each iteration
+		}
+		if n.Value != nil {
+			ast.Walk(inLoop, n.Value)
+		}
+		ast.Walk(v, n.X)
+		ast.Walk(inLoop, n.Body)
+		return nil
+	}
+
+	return v
+}
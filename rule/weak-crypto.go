@@ -0,0 +1,106 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+// weakCryptoAlternatives maps weak cryptographic packages to their stronger alternatives
+var weakCryptoAlternatives = map[string]string{
+	"crypto/md5":  "crypto/sha256",
+	"crypto/sha1": "crypto/sha256",
+	"crypto/des":  "crypto/aes",
+	"crypto/rc4":  "crypto/aes",
+	"math/rand":   "crypto/rand",
+}
+
+// WeakCryptoRule spots usages of weak cryptographic primitives.
+type WeakCryptoRule struct {
+	allowed map[string]bool
+	sync.Mutex
+}
+
+func (r *WeakCryptoRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.allowed != nil {
+		return
+	}
+
+	r.allowed = map[string]bool{}
+	for _, arg := range arguments {
+		pkg, ok := arg.(string)
+		if !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. Expecting a string, got %T", r.Name(), arg))
+		}
+		if _, ok := weakCryptoAlternatives[pkg]; !ok {
+			panic(fmt.Sprintf("Invalid argument to the %s rule. %q is not a package checked by the rule", r.Name(), pkg))
+		}
+		r.allowed[pkg] = true
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *WeakCryptoRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := lintWeakCrypto{file: file, allowed: r.allowed, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*WeakCryptoRule) Name() string {
+	return "weak-crypto"
+}
+
+type lintWeakCrypto struct {
+	file      *lint.File
+	allowed   map[string]bool
+	onFailure func(lint.Failure)
+}
+
+func (w lintWeakCrypto) Visit(node ast.Node) ast.Visitor {
+	sel, ok := node.(*ast.SelectorExpr)
+	if !ok {
+		return w
+	}
+
+	id, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return w
+	}
+
+	pkgName, ok := w.file.Pkg.TypesInfo().Uses[id].(*types.PkgName)
+	if !ok {
+		return w
+	}
+
+	path := pkgName.Imported().Path()
+	alternative, isWeak := weakCryptoAlternatives[path]
+	if !isWeak || w.allowed[path] {
+		return w
+	}
+
+	w.onFailure(lint.Failure{
+		Category:   "security",
+		Confidence: 0.8,
+		Node:       sel,
+		Failure:    fmt.Sprintf("%s.%s relies on %s that is cryptographically weak, use %s instead", id.Name, sel.Sel.Name, path, alternative),
+	})
+
+	return nil
+}
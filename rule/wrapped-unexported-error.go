@@ -0,0 +1,112 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/types"
+
+	"github.com/mgechev/revive/internal/typeparams"
+	"github.com/mgechev/revive/lint"
+)
+
+// WrappedUnexportedErrorRule spots exported functions returning errors of unexported types.
+type WrappedUnexportedErrorRule struct{}
+
+// Apply applies the rule to given file.
+func (*WrappedUnexportedErrorRule) Apply(file *lint.File, _ lint.Arguments) []lint.Failure {
+	var failures []lint.Failure
+
+	file.Pkg.TypeCheck()
+
+	for _, decl := range file.AST.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Body == nil || fn.Type.Results == nil || !fn.Name.IsExported() {
+			continue
+		}
+
+		thing := "function"
+		if fn.Recv != nil && len(fn.Recv.List) > 0 {
+			thing = "method"
+			if !ast.IsExported(typeparams.ReceiverType(fn)) {
+				continue
+			}
+		}
+
+		errorResults := map[int]bool{}
+		i := 0
+		for _, field := range fn.Type.Results.List {
+			isError := isIdent(field.Type, "error")
+			n := len(field.Names)
+			if n == 0 {
+				n = 1
+			}
+			for ; n > 0; n-- {
+				errorResults[i] = isError
+				i++
+			}
+		}
+
+		ast.Inspect(fn.Body, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncLit:
+				return false // returns of function literals are not returns of the function
+			case *ast.ReturnStmt:
+				for i, result := range n.Results {
+					if !errorResults[i] {
+						continue
+					}
+
+					typeName, ok := unexportedErrorType(file.Pkg, result)
+					if !ok {
+						continue
+					}
+
+					failures = append(failures, lint.Failure{
+						Category:   "unexported-type-in-api",
+						Confidence: 0.8,
+						Node:       result,
+						Failure:    fmt.Sprintf("exported %s %s returns an error of unexported type %s, callers in other packages can not target it with errors.As; export the type or return a sentinel error", thing, fn.Name.Name, typeName),
+					})
+				}
+			}
+			return true
+		})
+	}
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*WrappedUnexportedErrorRule) Name() string {
+	return "wrapped-unexported-error"
+}
+
+// unexportedErrorType returns the name of the concrete type of the given expression
+// if it is an unexported error type declared in the package
+func unexportedErrorType(pkg *lint.Package, expr ast.Expr) (string, bool) {
+	t := pkg.TypeOf(expr)
+	if t == nil || !implementsError(t) {
+		return "", false
+	}
+
+	prefix := ""
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+		prefix = "*"
+	}
+
+	named, ok := t.(*types.Named)
+	if !ok {
+		return "", false
+	}
+	if _, isInterface := named.Underlying().(*types.Interface); isInterface {
+		return "", false
+	}
+
+	obj := named.Obj()
+	if obj.Exported() || obj.Pkg() == nil || obj.Pkg() != pkg.TypesPkg() {
+		return "", false
+	}
+
+	return prefix + obj.Name(), true
+}
@@ -0,0 +1,179 @@
+package rule
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"sync"
+
+	"github.com/mgechev/revive/lint"
+)
+
+type zeroValueStyleType string
+
+const (
+	zeroValueStyleTypeVar     zeroValueStyleType = "var"
+	zeroValueStyleTypeLiteral zeroValueStyleType = "literal"
+)
+
+func zeroValueStyleFromString(s string) (zeroValueStyleType, error) {
+	switch s {
+	case string(zeroValueStyleTypeVar), "":
+		return zeroValueStyleTypeVar, nil
+	case string(zeroValueStyleTypeLiteral):
+		return zeroValueStyleTypeLiteral, nil
+	default:
+		return zeroValueStyleTypeVar, fmt.Errorf(
+			"invalid zero value style: %s (expecting one of %v)",
+			s,
+			[]zeroValueStyleType{
+				zeroValueStyleTypeVar,
+				zeroValueStyleTypeLiteral,
+			},
+		)
+	}
+}
+
+// ZeroValueLiteralRule enforces a consistent style for declaring zero-valued struct and array variables.
+type ZeroValueLiteralRule struct {
+	configured bool
+	style      zeroValueStyleType
+	sync.Mutex
+}
+
+func (r *ZeroValueLiteralRule) configure(arguments lint.Arguments) {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.configured {
+		return
+	}
+	r.configured = true
+
+	if len(arguments) < 1 {
+		r.style = zeroValueStyleTypeVar
+		return
+	}
+
+	style, ok := arguments[0].(string)
+	if !ok {
+		panic(fmt.Sprintf("Invalid argument '%v' for 'zero-value-literal' rule. Expecting string, got %T", arguments[0], arguments[0]))
+	}
+
+	var err error
+	r.style, err = zeroValueStyleFromString(style)
+	if err != nil {
+		panic(fmt.Sprintf("Invalid argument to the zero-value-literal rule: %v", err))
+	}
+}
+
+// Apply applies the rule to given file.
+func (r *ZeroValueLiteralRule) Apply(file *lint.File, arguments lint.Arguments) []lint.Failure {
+	r.configure(arguments)
+
+	var failures []lint.Failure
+	onFailure := func(failure lint.Failure) {
+		failures = append(failures, failure)
+	}
+
+	file.Pkg.TypeCheck()
+
+	w := &lintZeroValueLiteral{file: file, style: r.style, onFailure: onFailure}
+	ast.Walk(w, file.AST)
+
+	return failures
+}
+
+// Name returns the rule name.
+func (*ZeroValueLiteralRule) Name() string {
+	return "zero-value-literal"
+}
+
+type lintZeroValueLiteral struct {
+	file      *lint.File
+	style     zeroValueStyleType
+	inFunc    bool
+	onFailure func(lint.Failure)
+}
+
+func (w *lintZeroValueLiteral) Visit(node ast.Node) ast.Visitor {
+	switch n := node.(type) {
+	case *ast.FuncDecl:
+		if n.Body != nil {
+			ast.Walk(&lintZeroValueLiteral{w.file, w.style, true, w.onFailure}, n.Body)
+		}
+		return nil
+	case *ast.AssignStmt:
+		if w.style == zeroValueStyleTypeVar && n.Tok == token.DEFINE && len(n.Lhs) == len(n.Rhs) {
+			for i, rhs := range n.Rhs {
+				w.checkLiteral(rhs, func(typ string) string {
+					return fmt.Sprintf("%s := %s{}", gofmt(n.Lhs[i]), typ)
+				}, gofmt(n.Lhs[i]))
+			}
+		}
+	case *ast.ValueSpec:
+		w.checkValueSpec(n)
+	}
+
+	return w
+}
+
+func (w *lintZeroValueLiteral) checkValueSpec(spec *ast.ValueSpec) {
+	switch w.style {
+	case zeroValueStyleTypeVar:
+		if spec.Type != nil || len(spec.Names) != len(spec.Values) {
+			return
+		}
+		for i, value := range spec.Values {
+			w.checkLiteral(value, func(typ string) string {
+				return fmt.Sprintf("var %s = %s{}", spec.Names[i].Name, typ)
+			}, spec.Names[i].Name)
+		}
+	case zeroValueStyleTypeLiteral:
+		if !w.inFunc || spec.Type == nil || len(spec.Values) > 0 || !w.isStructOrArray(spec.Type) {
+			return
+		}
+		for _, name := range spec.Names {
+			if isBlank(name) {
+				continue
+			}
+			w.onFailure(lint.Failure{
+				Confidence: 1,
+				Node:       spec,
+				Category:   "style",
+				Failure:    fmt.Sprintf("use %s := %s{} instead of var %s %s", name.Name, gofmt(spec.Type), name.Name, gofmt(spec.Type)),
+			})
+		}
+	}
+}
+
+// checkLiteral reports expr if it is an empty composite literal of a struct or array type
+func (w *lintZeroValueLiteral) checkLiteral(expr ast.Expr, declaration func(typ string) string, name string) {
+	lit, ok := expr.(*ast.CompositeLit)
+	if !ok || len(lit.Elts) > 0 || lit.Type == nil || !w.isStructOrArray(lit) {
+		return
+	}
+
+	typ := gofmt(lit.Type)
+	w.onFailure(lint.Failure{
+		Confidence: 1,
+		Node:       lit,
+		Category:   "style",
+		Failure:    fmt.Sprintf("use var %s %s instead of %s", name, typ, declaration(typ)),
+	})
+}
+
+func (w *lintZeroValueLiteral) isStructOrArray(expr ast.Expr) bool {
+	t := w.file.Pkg.TypeOf(expr)
+	if t == nil {
+		return false
+	}
+
+	switch t.Underlying().(type) {
+	case *types.Struct, *types.Array:
+		return true
+	}
+
+	return false
+}
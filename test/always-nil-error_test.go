@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestAlwaysNilError(t *testing.T) {
+	testRule(t, "always-nil-error", &rule.AlwaysNilErrorRule{})
+	testRule(t, "always-nil-error-skip-interface-methods", &rule.AlwaysNilErrorRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"skipInterfaceMethods": true}},
+	})
+}
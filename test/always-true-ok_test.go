@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestAlwaysTrueOk(t *testing.T) {
+	testRule(t, "always-true-ok", &rule.AlwaysTrueOkRule{})
+	testRule(t, "always-true-ok-skip-interface-methods", &rule.AlwaysTrueOkRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"skipInterfaceMethods": true}},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestAmbiguousMutationSignature(t *testing.T) {
+	testRule(t, "ambiguous-mutation-signature", &rule.AmbiguousMutationSignatureRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestAssignmentInCondition(t *testing.T) {
+	testRule(t, "assignment-in-condition", &rule.AssignmentInConditionRule{})
+}
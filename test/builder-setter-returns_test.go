@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestBuilderSetterReturns(t *testing.T) {
+	testRule(t, "builder-setter-returns", &rule.BuilderSetterReturnsRule{})
+	testRule(t, "builder-setter-returns-patterns", &rule.BuilderSetterReturnsRule{}, &lint.RuleConfig{
+		Arguments: []any{"^Where$", "^And$", "^OrderBy$"},
+	})
+}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestCallArgumentLimit(t *testing.T) {
+	testRule(t, "call-argument-limit", &rule.CallArgumentLimitRule{}, &lint.RuleConfig{
+		Arguments: []any{int64(4)},
+	})
+}
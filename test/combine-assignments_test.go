@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestCombineAssignments(t *testing.T) {
+	testRule(t, "combine-assignments", &rule.CombineAssignmentsRule{})
+	testRule(t, "combine-assignments-min", &rule.CombineAssignmentsRule{}, &lint.RuleConfig{
+		Arguments: []any{int64(3)},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestConcurrentAppend(t *testing.T) {
+	testRule(t, "concurrent-append", &rule.ConcurrentAppendRule{})
+}
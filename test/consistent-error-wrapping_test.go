@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestConsistentErrorWrapping(t *testing.T) {
+	testRule(t, "consistent-error-wrapping", &rule.ConsistentErrorWrappingRule{})
+	testRule(t, "consistent-error-wrapping-preferred", &rule.ConsistentErrorWrappingRule{}, &lint.RuleConfig{
+		Arguments: []any{"%v"},
+	})
+}
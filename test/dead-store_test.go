@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDeadStore(t *testing.T) {
+	testRule(t, "dead-store", &rule.DeadStoreRule{})
+}
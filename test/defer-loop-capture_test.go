@@ -0,0 +1,17 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDeferLoopCapture(t *testing.T) {
+	testRule(t, "defer-loop-capture", &rule.DeferLoopCaptureRule{}, &lint.RuleConfig{
+		Arguments: []any{"1.21"},
+	})
+	testRule(t, "defer-loop-capture-go122", &rule.DeferLoopCaptureRule{}, &lint.RuleConfig{
+		Arguments: []any{"go1.22"},
+	})
+}
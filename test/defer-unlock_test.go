@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDeferUnlock(t *testing.T) {
+	testRule(t, "defer-unlock", &rule.DeferUnlockRule{})
+}
@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDeprecatedStdlib(t *testing.T) {
+	testRule(t, "deprecated-stdlib", &rule.DeprecatedStdlibRule{})
+	testRule(t, "deprecated-stdlib-custom", &rule.DeprecatedStdlibRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{
+			"net/http.Get":               "an http.Client with timeouts",
+			"github.com/pkg/errors.Wrap": "fmt.Errorf with %w",
+		}},
+	})
+}
@@ -18,3 +18,15 @@ func TestModifiedAnnotations(t *testing.T) {
 func TestDisableNextLineAnnotations(t *testing.T) {
 	testRule(t, "disable-annotations3", &rule.VarNamingRule{}, &lint.RuleConfig{})
 }
+
+func TestExpiringAnnotations(t *testing.T) {
+	testRule(t, "disable-annotations-expiry", &rule.VarNamingRule{}, &lint.RuleConfig{})
+}
+
+func TestBlockScopedAnnotations(t *testing.T) {
+	testRule(t, "disable-annotations-block", &rule.VarNamingRule{}, &lint.RuleConfig{})
+}
+
+func TestPackageLevelAnnotations(t *testing.T) {
+	testPackageRule(t, "disable-annotations-package", &rule.VarNamingRule{}, &lint.RuleConfig{})
+}
@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDocGoPackageComment(t *testing.T) {
+	testRule(t, "doc-go-package-comment-if-present", &rule.DocGoPackageCommentRule{})
+	testRule(t, "doc-go-package-comment", &rule.DocGoPackageCommentRule{}, &lint.RuleConfig{
+		Arguments: []any{"always"},
+	})
+	testPackageRule(t, "doc-go-package-comment-never", &rule.DocGoPackageCommentRule{}, &lint.RuleConfig{
+		Arguments: []any{"never"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestDoubleLock(t *testing.T) {
+	testRule(t, "double-lock", &rule.DoubleLockRule{})
+}
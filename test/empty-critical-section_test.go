@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestEmptyCriticalSection(t *testing.T) {
+	testRule(t, "empty-critical-section", &rule.EmptyCriticalSectionRule{})
+}
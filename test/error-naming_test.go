@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestErrorNaming(t *testing.T) {
+	testRule(t, "error-naming", &rule.ErrorNamingRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"prefix": "Failure", "checkNonErrors": true}},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestErrorStringComparison(t *testing.T) {
+	testRule(t, "error-string-comparison", &rule.ErrorStringComparisonRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestErrorfNotErrorsNewSprintf(t *testing.T) {
+	testRule(t, "errorf-not-errors-new-sprintf", &rule.ErrorfNotErrorsNewSprintfRule{})
+}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestErrorfWrapVerb(t *testing.T) {
+	testRule(t, "errorf-wrap-verb", &rule.ErrorfWrapVerbRule{}, &lint.RuleConfig{
+		Arguments: []any{"%q"},
+	})
+}
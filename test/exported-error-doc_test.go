@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestExportedErrorDoc(t *testing.T) {
+	testRule(t, "exported-error-doc", &rule.ExportedErrorDocRule{})
+	testRule(t, "exported-error-doc-pattern", &rule.ExportedErrorDocRule{}, &lint.RuleConfig{
+		Arguments: []any{`\bfails\b`},
+	})
+}
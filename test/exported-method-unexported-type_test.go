@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestExportedMethodUnexportedType(t *testing.T) {
+	testRule(t, "exported-method-unexported-type", &rule.ExportedMethodUnexportedTypeRule{})
+	testRule(t, "exported-method-unexported-type-allow-interfaces", &rule.ExportedMethodUnexportedTypeRule{}, &lint.RuleConfig{
+		Arguments: []any{"allowInterfaceMethods"},
+	})
+}
@@ -46,3 +46,35 @@ func TestFileExcludeFilterAtRuleLevel(t *testing.T) {
 		}
 	})
 }
+
+func TestFileIncludeFilterAtRuleLevel(t *testing.T) {
+	t.Run("is called if include match", func(t *testing.T) {
+		rule := &TestFileFilterRule{}
+		cfg := &lint.RuleConfig{Include: []string{"file-to-exclude.go"}}
+		cfg.Initialize()
+		testRule(t, "file-to-exclude", rule, cfg)
+		if !rule.WasApplyed {
+			t.Fatal("should call rule if included")
+		}
+	})
+
+	t.Run("not called if include not match", func(t *testing.T) {
+		rule := &TestFileFilterRule{}
+		cfg := &lint.RuleConfig{Include: []string{"no-matched.go"}}
+		cfg.Initialize()
+		testRule(t, "file-to-exclude", rule, cfg)
+		if rule.WasApplyed {
+			t.Fatal("should not call rule if not included")
+		}
+	})
+
+	t.Run("not called if both include and exclude match", func(t *testing.T) {
+		rule := &TestFileFilterRule{}
+		cfg := &lint.RuleConfig{Include: []string{"*"}, Exclude: []string{"file-to-exclude.go"}}
+		cfg.Initialize()
+		testRule(t, "file-to-exclude", rule, cfg)
+		if rule.WasApplyed {
+			t.Fatal("should not call rule if excluded")
+		}
+	})
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestFlagArgument(t *testing.T) {
+	testRule(t, "flag-argument", &rule.FlagArgumentRule{})
+	testRule(t, "flag-argument-threshold", &rule.FlagArgumentRule{}, &lint.RuleConfig{
+		Arguments: []any{int64(3)},
+	})
+}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestHardcodedSecret(t *testing.T) {
+	testRule(t, "hardcoded-secret", &rule.HardcodedSecretRule{})
+	testRule(t, "hardcoded-secret-custom", &rule.HardcodedSecretRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{
+			"namePattern":   "(?i)(password|pin)",
+			"valuePatterns": []any{"INT-[0-9]{10}"},
+			"allowedValues": []any{"hunter2"},
+		}},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestHTTPBodyClose(t *testing.T) {
+	testRule(t, "http-body-close", &rule.HTTPBodyCloseRule{})
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestIfAssignToTernary(t *testing.T) {
+	testRule(t, "if-assign-to-ternary", &rule.IfAssignToTernaryRule{})
+	testRule(t, "if-assign-to-ternary-assign", &rule.IfAssignToTernaryRule{}, &lint.RuleConfig{
+		Arguments: []any{"assign"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestImplicitExportedMethod(t *testing.T) {
+	testRule(t, "implicit-exported-method", &rule.ImplicitExportedMethodRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestIncomparableTypeCompare(t *testing.T) {
+	testRule(t, "incomparable-type-compare", &rule.IncomparableTypeCompareRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestInefficientPrepend(t *testing.T) {
+	testRule(t, "inefficient-prepend", &rule.InefficientPrependRule{})
+}
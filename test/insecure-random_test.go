@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestInsecureRandom(t *testing.T) {
+	testRule(t, "insecure-random", &rule.InsecureRandomRule{})
+	testRule(t, "insecure-random-custom-sinks", &rule.InsecureRandomRule{}, &lint.RuleConfig{
+		Arguments: []any{"(?i)invitation"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestIntegerDivision(t *testing.T) {
+	testRule(t, "integer-division", &rule.IntegerDivisionRule{})
+}
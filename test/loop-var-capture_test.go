@@ -0,0 +1,17 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestLoopVarCapture(t *testing.T) {
+	testRule(t, "loop-var-capture", &rule.LoopVarCaptureRule{}, &lint.RuleConfig{
+		Arguments: []any{"1.21"},
+	})
+	testRule(t, "loop-var-capture-go122", &rule.LoopVarCaptureRule{}, &lint.RuleConfig{
+		Arguments: []any{"go1.22"},
+	})
+}
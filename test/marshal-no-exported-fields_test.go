@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestMarshalNoExportedFields(t *testing.T) {
+	testRule(t, "marshal-no-exported-fields", &rule.MarshalNoExportedFieldsRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestMisplacedErrorfVerb(t *testing.T) {
+	testRule(t, "misplaced-errorf-verb", &rule.MisplacedErrorfVerbRule{})
+}
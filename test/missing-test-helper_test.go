@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestMissingTestHelper(t *testing.T) {
+	testRule(t, "missing-test-helper_test", &rule.MissingTestHelperRule{})
+	testRule(t, "missing-test-helper-support", &rule.MissingTestHelperRule{}, &lint.RuleConfig{
+		Arguments: []any{"**/*-support.go"},
+	})
+}
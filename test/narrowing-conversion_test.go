@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNarrowingConversion(t *testing.T) {
+	testRule(t, "narrowing-conversion", &rule.NarrowingConversionRule{})
+}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNilParamCheck(t *testing.T) {
+	testRule(t, "nil-param-check", &rule.NilParamCheckRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"ignoreTypes": []any{"http.Request"}}},
+	})
+}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoContextInStruct(t *testing.T) {
+	testRule(t, "no-context-in-struct", &rule.NoContextInStructRule{}, &lint.RuleConfig{
+		Arguments: []any{"requestScope"},
+	})
+}
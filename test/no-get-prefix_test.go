@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoGetPrefix(t *testing.T) {
+	testRule(t, "no-get-prefix", &rule.NoGetPrefixRule{})
+	testRule(t, "no-get-prefix-allowed", &rule.NoGetPrefixRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{
+			"allowInterfaceMethods": true,
+			"allowedNames":          []any{"GetID"},
+		}},
+	})
+}
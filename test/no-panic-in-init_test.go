@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoPanicInInit(t *testing.T) {
+	testRule(t, "no-panic-in-init", &rule.NoPanicInInitRule{})
+	testRule(t, "no-panic-in-init-allowed", &rule.NoPanicInInitRule{}, &lint.RuleConfig{
+		Arguments: []any{"fixtures"},
+	})
+}
@@ -0,0 +1,14 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoSensitiveLogging(t *testing.T) {
+	testRule(t, "no-sensitive-logging", &rule.NoSensitiveLoggingRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"types": []any{"fixtures.Token"}}},
+	})
+}
@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoSleepInTests(t *testing.T) {
+	testRule(t, "no-sleep-in-tests_test", &rule.NoSleepInTestsRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"maxDuration": "10ms"}},
+	})
+	testRule(t, "no-sleep-in-tests", &rule.NoSleepInTestsRule{})
+}
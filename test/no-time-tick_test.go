@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestNoTimeTick(t *testing.T) {
+	testRule(t, "no-time-tick", &rule.NoTimeTickRule{})
+}
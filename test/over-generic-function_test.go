@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestOverGenericFunction(t *testing.T) {
+	testRule(t, "over-generic-function", &rule.OverGenericFunctionRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPanicValueType(t *testing.T) {
+	testRule(t, "panic-value-type", &rule.PanicValueTypeRule{})
+}
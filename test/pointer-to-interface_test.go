@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPointerToInterface(t *testing.T) {
+	testRule(t, "pointer-to-interface", &rule.PointerToInterfaceRule{})
+	testRule(t, "pointer-to-interface-io", &rule.PointerToInterfaceRule{}, &lint.RuleConfig{
+		Arguments: []any{"ioInterfaces"},
+	})
+}
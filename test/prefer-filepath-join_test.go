@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPreferFilepathJoin(t *testing.T) {
+	testRule(t, "prefer-filepath-join", &rule.PreferFilepathJoinRule{})
+	testRule(t, "prefer-filepath-join-path-like-names", &rule.PreferFilepathJoinRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"pathLikeNamesOnly": true}},
+	})
+}
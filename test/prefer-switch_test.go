@@ -0,0 +1,12 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPreferSwitch(t *testing.T) {
+	testRule(t, "prefer-switch", &rule.PreferSwitchRule{}, &lint.RuleConfig{})
+}
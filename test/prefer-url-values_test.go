@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPreferURLValues(t *testing.T) {
+	testRule(t, "prefer-url-values", &rule.PreferURLValuesRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestPrematureInterface(t *testing.T) {
+	testRule(t, "premature-interface", &rule.PrematureInterfaceRule{})
+}
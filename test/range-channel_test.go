@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestRangeChannel(t *testing.T) {
+	testRule(t, "range-channel", &rule.RangeChannelRule{})
+	testRule(t, "range-channel-fields", &rule.RangeChannelRule{}, &lint.RuleConfig{
+		Arguments: []any{[]any{"fields"}},
+	})
+}
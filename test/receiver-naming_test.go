@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/mgechev/revive/internal/typeparams"
+	"github.com/mgechev/revive/lint"
 	"github.com/mgechev/revive/rule"
 )
 
@@ -13,3 +14,9 @@ func TestReceiverNamingTypeParams(t *testing.T) {
 	}
 	testRule(t, "receiver-naming-issue-669", &rule.ReceiverNamingRule{})
 }
+
+func TestReceiverNamingBannedNames(t *testing.T) {
+	testRule(t, "receiver-naming-banned", &rule.ReceiverNamingRule{}, &lint.RuleConfig{
+		Arguments: []any{"me", "that"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestRedundantAppendConversion(t *testing.T) {
+	testRule(t, "redundant-append-conversion", &rule.RedundantAppendConversionRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestRedundantLabel(t *testing.T) {
+	testRule(t, "redundant-label", &rule.RedundantLabelRule{})
+}
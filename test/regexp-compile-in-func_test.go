@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestRegexpCompileInFunc(t *testing.T) {
+	testRule(t, "regexp-compile-in-func", &rule.RegexpCompileInFuncRule{})
+	testRule(t, "regexp-compile-in-func-allow-init", &rule.RegexpCompileInFuncRule{}, &lint.RuleConfig{
+		Arguments: []any{[]any{"init", "tests"}},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestShadowedNamedResult(t *testing.T) {
+	testRule(t, "shadowed-named-result", &rule.ShadowedNamedResultRule{})
+}
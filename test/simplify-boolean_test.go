@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestSimplifyBoolean(t *testing.T) {
+	testRule(t, "simplify-boolean", &rule.SimplifyBooleanRule{})
+}
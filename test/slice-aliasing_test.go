@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestSliceAliasing(t *testing.T) {
+	testRule(t, "slice-aliasing", &rule.SliceAliasingRule{})
+}
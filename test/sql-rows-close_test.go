@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestSQLRowsClose(t *testing.T) {
+	testRule(t, "sql-rows-close", &rule.SQLRowsCloseRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestSQLUseContext(t *testing.T) {
+	testRule(t, "sql-use-context", &rule.SQLUseContextRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestStructTagAlignment(t *testing.T) {
+	testRule(t, "struct-tag-alignment", &rule.StructTagAlignmentRule{})
+}
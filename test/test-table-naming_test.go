@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestTestTableNaming(t *testing.T) {
+	testRule(t, "test-table-naming_test", &rule.TestTableNamingRule{})
+	testRule(t, "test-table-naming-custom_test", &rule.TestTableNamingRule{}, &lint.RuleConfig{
+		Arguments: []any{"cases"},
+	})
+}
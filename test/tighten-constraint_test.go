@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestTightenConstraint(t *testing.T) {
+	testRule(t, "tighten-constraint", &rule.TightenConstraintRule{})
+}
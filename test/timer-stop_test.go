@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestTimerStop(t *testing.T) {
+	testRule(t, "timer-stop", &rule.TimerStopRule{})
+	testRule(t, "timer-stop-require-defer", &rule.TimerStopRule{}, &lint.RuleConfig{
+		Arguments: []any{"requireDefer"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestTypedNilComparison(t *testing.T) {
+	testRule(t, "typed-nil-comparison", &rule.TypedNilComparisonRule{})
+}
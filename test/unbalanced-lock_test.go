@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUnbalancedLock(t *testing.T) {
+	testRule(t, "unbalanced-lock", &rule.UnbalancedLockRule{})
+}
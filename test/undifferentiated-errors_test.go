@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUndifferentiatedErrors(t *testing.T) {
+	testRule(t, "undifferentiated-errors", &rule.UndifferentiatedErrorsRule{})
+	testRule(t, "undifferentiated-errors-threshold", &rule.UndifferentiatedErrorsRule{}, &lint.RuleConfig{
+		Arguments: []any{int64(2)},
+	})
+}
@@ -0,0 +1,19 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUnkeyedStructLiteral(t *testing.T) {
+	testRule(t, "unkeyed-struct-literal", &rule.UnkeyedStructLiteralRule{}, &lint.RuleConfig{})
+	testRule(t, "unkeyed-struct-literal-configured", &rule.UnkeyedStructLiteralRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{
+			"allowTypes":          "^vec[0-9]$",
+			"minFields":           int64(3),
+			"ignoreImportedTypes": true,
+		}},
+	})
+}
@@ -0,0 +1,17 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUnnecessarySprintf(t *testing.T) {
+	testRule(t, "unnecessary-sprintf", &rule.UnnecessarySprintfRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"checkStringers": true}},
+	})
+	testRule(t, "unnecessary-sprintf-single-verb", &rule.UnnecessarySprintfRule{}, &lint.RuleConfig{
+		Arguments: []any{map[string]any{"allowSingleVerb": true}},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUnsignedLoopUnderflow(t *testing.T) {
+	testRule(t, "unsigned-loop-underflow", &rule.UnsignedLoopUnderflowRule{})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUnusedTypeParam(t *testing.T) {
+	testRule(t, "unused-type-param", &rule.UnusedTypeParamRule{})
+}
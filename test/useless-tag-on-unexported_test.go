@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestUselessTagOnUnexported(t *testing.T) {
+	testRule(t, "useless-tag-on-unexported", &rule.UselessTagOnUnexportedRule{})
+}
@@ -40,6 +40,62 @@ func testRule(t *testing.T, filename string, rule lint.Rule, config ...*lint.Rul
 	assertFailures(t, baseDir, stat, src, []lint.Rule{rule}, c)
 }
 
+// testPackageRule lints the files of the given directory as a single package,
+// the failures of each file must match its instructions.
+func testPackageRule(t *testing.T, dirname string, rule lint.Rule, config ...*lint.RuleConfig) {
+	baseDir := "../testdata/" + dirname + "/"
+	entries, err := os.ReadDir(baseDir)
+	if err != nil {
+		t.Fatalf("Bad directory path in test for %s: %v", rule.Name(), err)
+	}
+	c := map[string]lint.RuleConfig{}
+	if config != nil {
+		c[rule.Name()] = *config[0]
+	}
+
+	var filenames []string
+	expected := map[string][]instruction{}
+	for _, entry := range entries {
+		src, err := os.ReadFile(baseDir + entry.Name())
+		if err != nil {
+			t.Fatalf("Cannot read %s: %v", entry.Name(), err)
+		}
+		filenames = append(filenames, entry.Name())
+		expected[entry.Name()] = parseInstructions(t, entry.Name(), src)
+	}
+
+	l := lint.New(func(file string) ([]byte, error) {
+		return os.ReadFile(baseDir + file)
+	}, 0)
+	ps, err := l.Lint([][]string{filenames}, []lint.Rule{rule}, lint.Config{
+		Rules: c,
+	})
+	if err != nil {
+		t.Fatalf("Cannot lint %s: %v", dirname, err)
+	}
+
+	for p := range ps {
+		filename := p.Position.Start.Filename
+		ins := expected[filename]
+		found := false
+		for i, in := range ins {
+			if in.Line == p.Position.Start.Line && in.Match == p.Failure {
+				expected[filename] = append(ins[:i], ins[i+1:]...)
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("Unexpected problem at %s:%d: %v", filename, p.Position.Start.Line, p.Failure)
+		}
+	}
+	for filename, ins := range expected {
+		for _, in := range ins {
+			t.Errorf("Lint failed at %s:%d; /%v/ did not match", filename, in.Line, in.Match)
+		}
+	}
+}
+
 func assertSuccess(t *testing.T, baseDir string, fi os.FileInfo, rules []lint.Rule, config map[string]lint.RuleConfig) error {
 	l := lint.New(func(file string) ([]byte, error) {
 		return os.ReadFile(baseDir + file)
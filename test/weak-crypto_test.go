@@ -0,0 +1,15 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestWeakCrypto(t *testing.T) {
+	testRule(t, "weak-crypto", &rule.WeakCryptoRule{})
+	testRule(t, "weak-crypto-allowed", &rule.WeakCryptoRule{}, &lint.RuleConfig{
+		Arguments: []any{"crypto/md5", "crypto/sha1"},
+	})
+}
@@ -0,0 +1,11 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/rule"
+)
+
+func TestWrappedUnexportedError(t *testing.T) {
+	testRule(t, "wrapped-unexported-error", &rule.WrappedUnexportedErrorRule{})
+}
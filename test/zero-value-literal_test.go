@@ -0,0 +1,18 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/mgechev/revive/lint"
+	"github.com/mgechev/revive/rule"
+)
+
+func TestZeroValueLiteral(t *testing.T) {
+	testRule(t, "zero-value-literal", &rule.ZeroValueLiteralRule{})
+}
+
+func TestZeroValueLiteral_literal(t *testing.T) {
+	testRule(t, "zero-value-literal-literal", &rule.ZeroValueLiteralRule{}, &lint.RuleConfig{
+		Arguments: []any{"literal"},
+	})
+}
@@ -0,0 +1,55 @@
+package pkg
+
+import (
+	"errors"
+	"io"
+)
+
+func parse(s string) (int, error) { // MATCH /parse always returns a nil error, consider removing the error result/
+	if s == "" {
+		return 0, nil
+	}
+	return len(s), nil
+}
+
+func validate(s string) error {
+	if s == "" {
+		return errors.New("empty")
+	}
+	return nil
+}
+
+func named() (err error) {
+	defer func() {
+		err = errors.New("overridden")
+	}()
+	return nil
+}
+
+func panics() error {
+	panic("not implemented")
+}
+
+func withClosure() error { // MATCH /withClosure always returns a nil error, consider removing the error result/
+	f := func() error { return errors.New("inner") }
+	_ = f
+	return nil
+}
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { // MATCH /Close always returns a nil error, consider removing the error result/
+	return nil
+}
+
+type parser interface {
+	Parse(string) error
+}
+
+type lenient struct{}
+
+func (lenient) Parse(string) error { // MATCH /Parse always returns a nil error, consider removing the error result/
+	return nil
+}
+
+var _ io.Closer = nopCloser{}
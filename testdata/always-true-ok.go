@@ -0,0 +1,58 @@
+package pkg
+
+import "strings"
+
+func lookup(m map[string]int, key string) (int, bool) { // MATCH /lookup always returns true as its last result, consider removing the bool result/
+	if v, ok := m[key]; ok {
+		return v, true
+	}
+	return 0, true
+}
+
+func find(s []string, target string) (int, bool) {
+	for i, v := range s {
+		if v == target {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+func cut(s string) (string, bool) {
+	before, _, found := strings.Cut(s, "=")
+	return before, found
+}
+
+func named() (v int, ok bool) {
+	return 1, true
+}
+
+func single() bool {
+	return true
+}
+
+func delegating(m map[string]int) (int, bool) {
+	return lookup(m, "key")
+}
+
+func panics() (int, bool) {
+	panic("not implemented")
+}
+
+func withClosure() (string, bool) { // MATCH /withClosure always returns true as its last result, consider removing the bool result/
+	f := func() (string, bool) { return "", false }
+	_ = f
+	return "", true
+}
+
+type cache struct{}
+
+func (cache) Get(key string) (any, bool) { // MATCH /Get always returns true as its last result, consider removing the bool result/
+	return key, true
+}
+
+type getter interface {
+	Get(string) (any, bool)
+}
+
+var _ getter = cache{}
@@ -0,0 +1,41 @@
+package pkg
+
+type config struct{ name string }
+
+func normalize(m map[string]int) map[string]int { // MATCH /function normalize mutates its parameter m and returns a value of the same type, callers can not tell if the result is m or a copy; either mutate in place without returning it or return a modified copy/
+	for k, v := range m {
+		if v < 0 {
+			m[k] = 0
+		}
+	}
+	return m
+}
+
+func prune(m map[string]int) (map[string]int, int) { // MATCH /function prune mutates its parameter m and returns a value of the same type, callers can not tell if the result is m or a copy; either mutate in place without returning it or return a modified copy/
+	delete(m, "")
+	return m, len(m)
+}
+
+func rename(c *config, name string) *config { // MATCH /function rename mutates its parameter c and returns a value of the same type, callers can not tell if the result is c or a copy; either mutate in place without returning it or return a modified copy/
+	c.name = name
+	return c
+}
+
+func doubled(s []int) []int {
+	result := make([]int, len(s))
+	for i, v := range s {
+		result[i] = v * 2
+	}
+	return result
+}
+
+func reset(s []int) {
+	for i := range s {
+		s[i] = 0
+	}
+}
+
+func counts(s []string) map[string]int {
+	s[0] = ""
+	return nil
+}
@@ -0,0 +1,56 @@
+package fixtures
+
+import "os"
+
+var global int
+
+func conditions(x []int, f *os.File) (int, error) {
+	var n int
+	if n = len(x); n > 0 { // MATCH /the if statement initializer assigns n declared outside of it but not used elsewhere, use := to declare variables scoped to the if statement/
+		return 1, nil
+	}
+
+	var m int
+	if m = len(x); m > 1 {
+		m--
+	}
+	_ = m
+
+	var err error
+	if _, err = f.Stat(); err != nil { // MATCH /the if statement initializer assigns err declared outside of it but not used elsewhere, use := to declare variables scoped to the if statement/
+		return 0, nil
+	}
+
+	var kind string
+	switch kind = f.Name(); kind { // MATCH /the switch statement initializer assigns kind declared outside of it but not used elsewhere, use := to declare variables scoped to the switch statement/
+	case "a":
+	}
+
+	if global = len(x); global > 2 {
+		return 2, nil
+	}
+
+	var count int
+	if count = len(x); count > 3 {
+		return count, nil
+	}
+	return count, nil
+}
+
+func loop(x []int) {
+	var i int
+	for {
+		if i = len(x); i > 0 { // MATCH /the if statement initializer assigns i declared outside of it but not used elsewhere, use := to declare variables scoped to the if statement/
+			break
+		}
+	}
+}
+
+func reused(x []int) int {
+	n := 1
+	n++
+	if n = len(x); n > 0 {
+		return 0
+	}
+	return 1
+}
@@ -0,0 +1,24 @@
+package fixtures
+
+type QueryBuilder struct {
+	where []string
+	order string
+}
+
+func (q *QueryBuilder) Where(cond string) *QueryBuilder {
+	q.where = append(q.where, cond)
+	return q
+}
+
+func (q *QueryBuilder) And(cond string) *QueryBuilder {
+	q.where = append(q.where, cond)
+	return q
+}
+
+func (q *QueryBuilder) OrderBy(field string) { // MATCH /setter OrderBy does not return the receiver, unlike other setters of QueryBuilder, thus it breaks method chaining/
+	q.order = field
+}
+
+func (q *QueryBuilder) SetOrder(field string) { // does not match the configured patterns
+	q.order = field
+}
@@ -0,0 +1,83 @@
+package fixtures
+
+type RequestBuilder struct {
+	url     string
+	method  string
+	headers map[string]string
+	body    []byte
+}
+
+func (b *RequestBuilder) WithURL(url string) *RequestBuilder {
+	b.url = url
+	return b
+}
+
+func (b *RequestBuilder) WithMethod(method string) *RequestBuilder {
+	b.method = method
+	return b
+}
+
+func (b *RequestBuilder) SetHeader(k, v string) *RequestBuilder {
+	b.headers[k] = v
+	return b
+}
+
+func (b *RequestBuilder) SetBody(body []byte) { // MATCH /setter SetBody does not return the receiver, unlike other setters of RequestBuilder, thus it breaks method chaining/
+	b.body = body
+}
+
+func (b *RequestBuilder) Build() string {
+	return b.url
+}
+
+type Options struct {
+	a, b int
+}
+
+func (o Options) WithA(a int) Options {
+	o.a = a
+	return o
+}
+
+func (o Options) WithB(b int) Options {
+	o.b = b
+	return o
+}
+
+func (o *Options) SetA(a int) error { // MATCH /setter SetA does not return the receiver, unlike other setters of Options, thus it breaks method chaining/
+	o.a = a
+	return nil
+}
+
+// not a builder: most setters do not return the receiver
+type Config struct {
+	a, b, c int
+}
+
+func (c *Config) SetA(a int) { c.a = a }
+
+func (c *Config) SetB(b int) { c.b = b }
+
+func (c *Config) WithC(v int) *Config {
+	c.c = v
+	return c
+}
+
+type Generic[T any] struct {
+	v T
+}
+
+func (g *Generic[T]) WithV(v T) *Generic[T] {
+	g.v = v
+	return g
+}
+
+func (g *Generic[T]) WithZero() *Generic[T] {
+	var zero T
+	g.v = zero
+	return g
+}
+
+func (g *Generic[T]) SetV(v T) { // MATCH /setter SetV does not return the receiver, unlike other setters of Generic, thus it breaks method chaining/
+	g.v = v
+}
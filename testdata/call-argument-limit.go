@@ -0,0 +1,25 @@
+package fixtures
+
+import "fmt"
+
+func draw(x, y, w, h int, color string) {}
+
+func drawAll(values ...int) {}
+
+func logf(level int, format string, args ...any) {}
+
+type canvas struct{}
+
+func (canvas) rect(x, y, w, h, border int, color string) {}
+
+func calls(c canvas, values []int) {
+	draw(1, 2, 3, 4, "red") // MATCH /call to draw passes 5 positional arguments, more than the maximum of 4; consider a wrapper function or an options struct/
+	c.rect(1, 2, 3, 4, 1, "red") // MATCH /call to c.rect passes 6 positional arguments, more than the maximum of 4; consider a wrapper function or an options struct/
+
+	drawAll(1, 2, 3, 4, 5, 6)
+	drawAll(values...)
+	logf(1, "%d %d %d %d", 1, 2, 3, 4)
+	fmt.Println(1, 2, 3, 4, 5, 6)
+	values = append(values, 1, 2, 3, 4, 5)
+	func(a, b, c, d, e int) {}(1, 2, 3, 4, 5) // MATCH /call to function literal passes 5 positional arguments, more than the maximum of 4; consider a wrapper function or an options struct/
+}
@@ -0,0 +1,13 @@
+package fixtures
+
+func combine(x, y, z int) int {
+	a := x
+	b := y
+	var c int
+
+	a = x + 1 // MATCH /3 consecutive independent assignments could be combined into a single one: a, b, c = x + 1, y * 2, z/
+	b = y * 2
+	c = z
+
+	return a + b + c
+}
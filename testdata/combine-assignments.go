@@ -0,0 +1,41 @@
+package fixtures
+
+func combine(x, y int) int {
+	a := x // MATCH /2 consecutive independent assignments could be combined into a single one: a, b := x, y/
+	b := y
+	var c int
+
+	a = x + 1 // MATCH /3 consecutive independent assignments could be combined into a single one: a, b, c = x + 1, y * 2, 0/
+	b = y * 2
+	c = 0
+
+	println()
+	a = 1
+	b = a // depends on the previous assignment
+
+	a = g() // has side effects
+	b = g()
+
+	a = 1
+	a = 2 // assigns the same variable
+
+	ch := make(chan int)
+	a = <-ch
+	b = <-ch
+
+	d := 1
+	b = 2
+
+	switch {
+	case a > b:
+		a = b
+		b = a // not a swap
+	case a < b:
+		a = y // MATCH /2 consecutive independent assignments could be combined into a single one: a, b = y, x/
+		b = x
+	}
+
+	return a + b + c + d
+}
+
+func g() int { return 0 }
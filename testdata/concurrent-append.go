@@ -0,0 +1,40 @@
+package fixtures
+
+import "sync"
+
+type collector struct {
+	mu    sync.Mutex
+	items []int
+}
+
+func collect(values []int, c *collector) []int {
+	var results []int
+	var wg sync.WaitGroup
+	for _, v := range values {
+		wg.Add(1)
+		go func(v int) {
+			defer wg.Done()
+			results = append(results, v*2) // MATCH /appending to results, declared outside of the goroutine, is a data race if other goroutines access it; protect it with a mutex or send the values over a channel/
+		}(v)
+	}
+
+	go func() {
+		c.items = append(c.items, 1) // MATCH /appending to c.items, declared outside of the goroutine, is a data race if other goroutines access it; protect it with a mutex or send the values over a channel/
+	}()
+
+	go func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		c.items = append(c.items, 2)
+	}()
+
+	go func() {
+		var local []int
+		local = append(local, 3)
+		_ = local
+	}()
+
+	results = append(results, 4)
+	wg.Wait()
+	return results
+}
@@ -0,0 +1,22 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+)
+
+var errNotFound = errors.New("not found")
+
+func load(name string) error {
+	if name == "" {
+		return fmt.Errorf("loading %q: %w", name, errNotFound)
+	}
+	if name == "?" {
+		return fmt.Errorf("loading %q: %v", name, errNotFound) // MATCH /inconsistent error wrapping, errors are formatted with %v instead of %w/
+	}
+	return fmt.Errorf("invalid name %q", name)
+}
+
+func save(name string, err error) error {
+	return fmt.Errorf("saving %s: %w", name, err)
+}
@@ -52,3 +52,14 @@ func y(s string, r int, ctx context.Context, x int) { // MATCH /context.Context
 func y(ctx1 context.Context, ctx2 context.Context, x int) {}
 
 func y(ctx1 context.Context, ctx2 context.Context, x int, ctx3 context.Context) {} // MATCH /context.Context should be the first parameter of a function/
+
+func z() {
+	_ = func(ctx context.Context, s string) { // ok
+	}
+
+	_ = func(t *testing.T, ctx context.Context) { // ok
+	}
+
+	_ = func(s string, ctx context.Context) { // MATCH /context.Context should be the first parameter of a function/
+	}
+}
@@ -0,0 +1,107 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+func compute() int { return 1 }
+
+func deadStores(s string) (int, error) {
+	x := compute() // MATCH /the value assigned to x is never read, it is overwritten before being used/
+	x = compute() * 2
+
+	n, err := strconv.Atoi(s) // MATCH /the value assigned to err is never read, it is overwritten before being used/
+	m, err := strconv.Atoi(s + "0")
+	if err != nil {
+		return 0, err
+	}
+
+	var msg = "first" // MATCH /the value assigned to msg is never read, it is overwritten before being used/
+	fmt.Println(n, m)
+	msg = "second"
+	fmt.Println(msg)
+
+	total := 0
+	total = 1 // MATCH /the value assigned to total is never read, it is overwritten before being used/
+	total = x + n
+	return total, nil
+}
+
+type counter struct{ n int }
+
+func (c *counter) inc() { c.n++ }
+
+func liveStores(values []int) int {
+	x := compute()
+	x = x + 1 // reads the previous value
+
+	y := compute()
+	if y > 0 {
+		y = 2
+	}
+	y = 3
+	fmt.Println(y)
+
+	var zero int // declarations with zero values are fine
+	zero = 1
+	var err error = nil
+	err = errors.New("fail")
+	fmt.Println(zero, err)
+
+	for _, v := range values {
+		z := v
+		if v == 0 {
+			continue
+		}
+		z = v * 2
+		fmt.Println(z)
+	}
+
+	captured := compute()
+	print := func() { fmt.Println(captured) }
+	print()
+	captured = 2
+	print()
+
+	addressed := compute()
+	p := &addressed
+	addressed = 2
+	fmt.Println(*p)
+
+	c := counter{}
+	c.inc()
+	c = counter{n: 1}
+	c.inc()
+
+	var last int
+	for i := 0; i < 3; i++ {
+		fmt.Println(last)
+		last = i
+	}
+
+	return x
+}
+
+func loops() {
+	for i := 0; i < 3; i++ {
+		v := i // MATCH /the value assigned to v is never read, it is overwritten before being used/
+		v = i * 2
+		fmt.Println(v)
+	}
+
+	switch compute() {
+	case 1:
+		r := "a" // MATCH /the value assigned to r is never read, it is overwritten before being used/
+		r = "b"
+		fmt.Println(r)
+	}
+
+	f := func() int {
+		w := compute() // MATCH /the value assigned to w is never read, it is overwritten before being used/
+		w = 2
+		return w
+	}
+	fmt.Println(f())
+}
@@ -0,0 +1,19 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+)
+
+func closeAll(files []*os.File, names []string) {
+	for _, f := range files {
+		defer func() {
+			f.Close()
+		}()
+	}
+
+	var name string
+	for _, name = range names {
+		defer func() { fmt.Println(name) }() // MATCH /deferred closure captures the loop variable(s) name, all the deferred calls will see their last value; pass them as arguments of the closure/
+	}
+}
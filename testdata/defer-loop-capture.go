@@ -0,0 +1,38 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+)
+
+func closeAll(files []*os.File, names []string) {
+	for _, f := range files {
+		defer func() { // MATCH /deferred closure captures the loop variable(s) f, all the deferred calls will see their last value; pass them as arguments of the closure/
+			f.Close()
+		}()
+	}
+
+	for i, name := range names {
+		defer fmt.Println(i, name)
+		defer func(name string) {
+			fmt.Println(name)
+		}(name)
+	}
+
+	for i := 0; i < len(names); i++ {
+		if names[i] == "" {
+			defer func() { fmt.Println(names[i], i) }() // MATCH /deferred closure captures the loop variable(s) i, all the deferred calls will see their last value; pass them as arguments of the closure/
+		}
+	}
+
+	var name string
+	for _, name = range names {
+		defer func() { fmt.Println(name) }() // MATCH /deferred closure captures the loop variable(s) name, all the deferred calls will see their last value; pass them as arguments of the closure/
+	}
+
+	for _, f := range files {
+		func() {
+			defer func() { f.Close() }()
+		}()
+	}
+}
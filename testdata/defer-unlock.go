@@ -0,0 +1,73 @@
+package pkg
+
+import "sync"
+
+type cache struct {
+	mu    sync.RWMutex
+	items map[string]string
+	sync.Mutex
+}
+
+func (c *cache) get(k string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.items[k]
+}
+
+func (c *cache) set(k, v string) {
+	defer c.mu.Unlock() // MATCH /deferred call to c.mu.Unlock without a preceding call to c.mu.Lock in the same function/
+	c.items[k] = v
+}
+
+func (c *cache) mismatch(k string) string {
+	c.mu.RLock()
+	defer c.mu.Unlock() // MATCH /deferred call to c.mu.Unlock without a preceding call to c.mu.Lock in the same function/
+	return c.items[k]
+}
+
+func (c *cache) embedded() {
+	c.Lock()
+	defer c.Unlock()
+}
+
+func (c *cache) embeddedWithoutLock() {
+	defer c.Unlock() // MATCH /deferred call to c.Unlock without a preceding call to c.Lock in the same function/
+}
+
+func (c *cache) conditional(lock bool) {
+	if lock {
+		c.mu.Lock()
+	}
+	defer c.mu.Unlock()
+}
+
+func (c *cache) try() bool {
+	if !c.mu.TryLock() {
+		return false
+	}
+	defer c.mu.Unlock()
+	return true
+}
+
+func (c *cache) closure() {
+	c.mu.Lock()
+	func() {
+		defer c.mu.Unlock() // MATCH /deferred call to c.mu.Unlock without a preceding call to c.mu.Lock in the same function/
+	}()
+}
+
+func locker(l sync.Locker) {
+	defer l.Unlock() // MATCH /deferred call to l.Unlock without a preceding call to l.Lock in the same function/
+}
+
+type fake struct{}
+
+func (fake) Unlock() {}
+
+func notAMutex(f fake) {
+	defer f.Unlock()
+}
+
+func waitGroup(wg *sync.WaitGroup) {
+	defer wg.Done()
+}
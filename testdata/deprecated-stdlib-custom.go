@@ -0,0 +1,14 @@
+package fixtures
+
+import (
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+)
+
+func deprecated() error {
+	_, _ = ioutil.ReadAll(nil)            // MATCH /ioutil.ReadAll is deprecated, use io.ReadAll instead/
+	_, _ = http.Get("https://revive.run") // MATCH /http.Get is deprecated, use an http.Client with timeouts instead/
+	return errors.Wrap(nil, "message")    // MATCH /errors.Wrap is deprecated, use fmt.Errorf with %w instead/
+}
@@ -0,0 +1,24 @@
+package fixtures
+
+import (
+	"io/ioutil"
+	"math/rand"
+	"os"
+	str "strings"
+)
+
+func deprecated() {
+	data, _ := ioutil.ReadFile("f") // MATCH /ioutil.ReadFile is deprecated, use os.ReadFile instead/
+	rand.Seed(42)                   // MATCH /rand.Seed is deprecated, use rand.New(rand.NewSource(seed)) instead/
+	_ = str.Title("title")          // MATCH /str.Title is deprecated, use golang.org/x/text/cases instead/
+	_ = os.SEEK_END                 // MATCH /os.SEEK_END is deprecated, use io.SeekEnd instead/
+	_, _ = os.ReadFile("f")
+	_ = rand.Intn(10)
+	_ = data
+}
+
+type local struct{ ReadFile func() }
+
+func shadowed(ioutil local) {
+	ioutil.ReadFile()
+}
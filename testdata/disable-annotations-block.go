@@ -0,0 +1,29 @@
+// Package fixtures is a testing package
+package fixtures
+
+func foo1() {
+	//revive:disable-block:var-naming legacy names
+	var invalid_name = 0
+	if true {
+		var another_name = 0
+	}
+}
+
+func foo2() {
+	var invalid_name = 0 // MATCH /don't use underscores in Go names; var invalid_name should be invalidName/
+	if true {
+		//revive:disable-block:var-naming
+		var inner_name = 0
+		for {
+			//revive:disable-block:var-naming nested
+			var nested_name = 0
+		}
+		var after_nested = 0
+	}
+	var after_block = 0 // MATCH /don't use underscores in Go names; var after_block should be afterBlock/
+}
+
+func foo3() {
+	//revive:enable-block:var-naming
+	var invalid_name = 0 // MATCH /don't use underscores in Go names; var invalid_name should be invalidName/
+}
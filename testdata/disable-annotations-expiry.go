@@ -0,0 +1,19 @@
+// Package fixtures is a testing package
+package fixtures
+
+func foo1() {
+	//revive:disable-next-line:var-naming until=2999-12-31 tech debt
+	var invalid_name = 0
+}
+
+func foo2() {
+	//revive:disable-next-line:var-naming until=2000-01-01 tech debt
+	var invalid_name = 0 // MATCH /don't use underscores in Go names; var invalid_name should be invalidName/
+	// MATCH:10 /lint disabling expired on 2000-01-01, fix the disabled failures or postpone the expiry date/
+}
+
+func foo3() {
+	//revive:disable-next-line:var-naming until=tomorrow
+	var invalid_name = 0 // MATCH /don't use underscores in Go names; var invalid_name should be invalidName/
+	// MATCH:16 /invalid expiry date of lint disabling: expecting until=YYYY-MM-DD, got "until=tomorrow"/
+}
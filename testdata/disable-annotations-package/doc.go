@@ -0,0 +1,6 @@
+// Package fixtures is a testing package
+//
+//revive:disable:var-naming legacy names kept for compatibility
+package fixtures
+
+var invalid_name = 0
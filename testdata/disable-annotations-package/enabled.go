@@ -0,0 +1,11 @@
+package fixtures
+
+var old_name = 0
+
+//revive:enable:var-naming
+
+var new_name = 0 // MATCH /don't use underscores in Go names; var new_name should be newName/
+
+func enabled() {
+	var local_name = 0 // MATCH /don't use underscores in Go names; var local_name should be localName/
+}
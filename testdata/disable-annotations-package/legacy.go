@@ -0,0 +1,7 @@
+package fixtures
+
+var legacy_name = 0
+
+func legacy() {
+	var another_name = 0
+}
@@ -0,0 +1,2 @@
+// Package pkg does things.
+package pkg
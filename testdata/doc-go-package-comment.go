@@ -0,0 +1,4 @@
+// Package pkg does things.
+package pkg
+
+// MATCH:1 /the package comment should be in doc.go/
@@ -0,0 +1,67 @@
+package fixtures
+
+import "sync"
+
+type store struct {
+	mu   sync.Mutex
+	rw   sync.RWMutex
+	data map[string]int
+}
+
+func (s *store) get(key string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.data[key]
+}
+
+func (s *store) double(key string) {
+	s.mu.Lock()
+	s.data[key]++
+	s.mu.Lock() // MATCH /s.mu is locked again before being unlocked, this deadlocks because mutexes are not reentrant/
+	s.mu.Unlock()
+}
+
+func (s *store) sequential() {
+	s.mu.Lock()
+	s.data["a"]++
+	s.mu.Unlock()
+	s.mu.Lock()
+	s.data["b"]++
+	s.mu.Unlock()
+}
+
+func (s *store) deferred() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.data) == 0 {
+		s.mu.Lock() // MATCH /s.mu is locked again before being unlocked, this deadlocks because mutexes are not reentrant/
+	}
+}
+
+func (s *store) readLocks() {
+	s.rw.RLock()
+	s.rw.RLock()
+	s.rw.RUnlock()
+	s.rw.RUnlock()
+
+	s.rw.Lock()
+	s.rw.Lock() // MATCH /s.rw is locked again before being unlocked, this deadlocks because mutexes are not reentrant/
+}
+
+func (s *store) conditional(cond bool) {
+	if cond {
+		s.mu.Lock()
+	}
+	s.mu.Lock()
+
+	var a, b sync.Mutex
+	a.Lock()
+	b.Lock()
+	b.Unlock()
+	a.Unlock()
+
+	go func() {
+		s.mu.Lock()
+		s.mu.Unlock()
+	}()
+}
@@ -0,0 +1,42 @@
+package fixtures
+
+import "sync"
+
+type cache struct {
+	mu    sync.RWMutex
+	items map[string]string
+}
+
+type fakeLock struct{}
+
+func (fakeLock) Lock()   {}
+func (fakeLock) Unlock() {}
+
+func (c *cache) sections(key string, value string, fake fakeLock) {
+	var mu sync.Mutex
+	mu.Lock() // MATCH /empty critical section: mu.Lock() is immediately followed by mu.Unlock(), remove them or move the guarded code between them/
+	mu.Unlock()
+
+	c.mu.RLock() // MATCH /empty critical section: c.mu.RLock() is immediately followed by c.mu.RUnlock(), remove them or move the guarded code between them/
+	c.mu.RUnlock()
+
+	c.mu.Lock()
+	c.items[key] = value
+	c.mu.Unlock()
+
+	c.mu.RLock()
+	c.mu.Unlock()
+
+	fake.Lock()
+	fake.Unlock()
+
+	var other sync.Mutex
+	mu.Lock()
+	other.Unlock()
+
+	switch key {
+	case "a":
+		mu.Lock() // MATCH /empty critical section: mu.Lock() is immediately followed by mu.Unlock(), remove them or move the guarded code between them/
+		mu.Unlock()
+	}
+}
@@ -0,0 +1,28 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+var (
+	FailTimeout   = errors.New("timeout")          // MATCH /error var FailTimeout should have name of the form FailureFoo/
+	FailureClosed = fmt.Errorf("closed: %d", 1)    // exported error var
+	failureEOF    = errors.New("eof")              // unexported error var
+	badName       = fmt.Errorf("wrapped: %w", nil) // MATCH /error var badName should have name of the form failureFoo/
+	FailureCount  = 0                              // MATCH /var FailureCount is named as an error var but is of type int, which does not implement error/
+	failureClient *http.Client                     // MATCH /var failureClient is named as an error var but is of type *http.Client, which does not implement error/
+	Failures      []error                          // not named as an error var
+	FailureKind   error                            // holds an error
+	FailureOther  = customError{}                  // holds an error
+)
+
+type customError struct{}
+
+func (customError) Error() string { return "custom" }
+
+func scoped() {
+	var FailureLocal = 0 // not at package level
+	_ = FailureLocal
+}
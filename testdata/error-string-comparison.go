@@ -0,0 +1,45 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+)
+
+type myError struct{}
+
+func (myError) Error() string { return "my error" }
+
+type notAnError struct {
+	Error func() string
+}
+
+func compare(err error) bool {
+	if err.Error() == "not found" { // MATCH /comparing the message of err with a string literal is brittle, use a sentinel error and errors.Is instead/
+		return true
+	}
+
+	if "timeout" != err.Error() { // MATCH /comparing the message of err with a string literal is brittle, use a sentinel error and errors.Is instead/
+		return false
+	}
+
+	var me myError
+	if me.Error() == "my error" { // MATCH /comparing the message of me with a string literal is brittle, use a sentinel error and errors.Is instead/
+		return true
+	}
+
+	if errors.New("x").Error() == "x" { // MATCH /comparing the message of errors.New("x") with a string literal is brittle, use a sentinel error and errors.Is instead/
+		return true
+	}
+
+	msg := "not found"
+	if err.Error() == msg {
+		return true
+	}
+
+	if err.Error() == fmt.Sprint("not found") {
+		return true
+	}
+
+	var other notAnError
+	return other.Error() == "" && err.Error() > "a"
+}
@@ -0,0 +1,21 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+
+	stderrors "errors"
+)
+
+func fail(name string, args []any) error {
+	if name == "" {
+		return errors.New(fmt.Sprintf("invalid name %q", name)) // MATCH /use fmt.Errorf(...) instead of errors.New(fmt.Sprintf(...))/
+	}
+	if len(args) == 0 {
+		return stderrors.New(fmt.Sprintf("no arguments")) // MATCH /unnecessary call to fmt.Sprintf, use stderrors.New("no arguments") instead/
+	}
+	if len(args) > 10 {
+		return errors.New(fmt.Sprintf("too many arguments: %v", args...))
+	}
+	return errors.New(fmt.Sprint(name))
+}
@@ -0,0 +1,26 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+)
+
+type customError struct{}
+
+func (customError) Error() string { return "custom" }
+
+func wrapping(err error, name string, custom *customError) error {
+	_ = fmt.Errorf("reading %s: %w", name, err)
+	_ = fmt.Errorf("reading %s: %v", name, name)
+	_ = fmt.Errorf("100%% failed: %w", err)
+	_ = fmt.Errorf("error type %T", err)
+	_ = fmt.Errorf("reading %[2]s: %[1]v", err, name)
+	_ = errors.New(fmt.Sprintf("reading: %v", err))
+
+	_ = fmt.Errorf("reading %s: %v", name, err) // MATCH /error err is formatted with %v, use %w to wrap it instead/
+	_ = fmt.Errorf("100%% failed: %s", err)     // MATCH /error err is formatted with %s, use %w to wrap it instead/
+	_ = fmt.Errorf("%*d: %+v", 3, 42, custom)   // MATCH /error custom is formatted with %v, use %w to wrap it instead/
+	_ = fmt.Errorf("ignored: %q", err)
+
+	return fmt.Errorf("failed: %v", errors.New("boom")) // MATCH /error errors.New("boom") is formatted with %v, use %w to wrap it instead/
+}
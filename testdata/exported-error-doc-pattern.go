@@ -0,0 +1,11 @@
+package pkg
+
+// Load reads the item, it fails if the item does not exist.
+func Load(name string) ([]byte, error) {
+	return nil, nil
+}
+
+// Save writes the item, it returns an error if the item cannot be written.
+func Save(name string, data []byte) error { // MATCH /comment on exported function Save should document the errors it returns/
+	return nil
+}
@@ -0,0 +1,56 @@
+package pkg
+
+import "errors"
+
+// ErrNotFound is returned when the item does not exist.
+var ErrNotFound = errors.New("not found")
+
+// Load reads the item with the given name.
+func Load(name string) ([]byte, error) { // MATCH /comment on exported function Load should document the errors it returns/
+	return nil, nil
+}
+
+// Save writes the item with the given name.
+// It returns an error if the item cannot be written.
+func Save(name string, data []byte) error {
+	return nil
+}
+
+// Find looks for the item with the given name, it returns ErrNotFound if there is none.
+func Find(name string) (string, error) {
+	return "", ErrNotFound
+}
+
+// Delete removes the item; err is non-nil if the item is locked.
+func Delete(name string) (err error) {
+	return nil
+}
+
+func Undocumented() error {
+	return nil
+}
+
+// unexported does nothing.
+func unexported() error {
+	return nil
+}
+
+// Count counts the items.
+func Count() int {
+	return 0
+}
+
+// Store stores items.
+type Store struct{}
+
+// Open opens the store.
+func (*Store) Open() error { // MATCH /comment on exported method Open should document the errors it returns/
+	return nil
+}
+
+type store struct{}
+
+// Open opens the store.
+func (store) Open() error {
+	return nil
+}
@@ -0,0 +1,25 @@
+package pkg
+
+import "fmt"
+
+type Shape interface {
+	Area() float64
+}
+
+type square struct{ side float64 }
+
+func (s square) Area() float64 {
+	return s.side * s.side
+}
+
+func (s *square) Scale(f float64) { // MATCH /exported method Scale of unexported type square is not reachable from other packages/
+	s.side *= f
+}
+
+func (s square) String() string {
+	return fmt.Sprintf("square(%v)", s.side)
+}
+
+type notFound struct{}
+
+func (notFound) Error() string { return "not found" }
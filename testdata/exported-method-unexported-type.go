@@ -0,0 +1,31 @@
+package pkg
+
+import "fmt"
+
+type Shape interface {
+	Area() float64
+}
+
+type square struct{ side float64 }
+
+func (s square) Area() float64 { // MATCH /exported method Area of unexported type square is only reachable through interfaces/
+	return s.side * s.side
+}
+
+func (s *square) Scale(f float64) { // MATCH /exported method Scale of unexported type square is not reachable from other packages/
+	s.side *= f
+}
+
+func (s square) String() string { // MATCH /exported method String of unexported type square is only reachable through interfaces/
+	return fmt.Sprintf("square(%v)", s.side)
+}
+
+func (s square) perimeter() float64 {
+	return 4 * s.side
+}
+
+type Circle struct{ radius float64 }
+
+func (c Circle) Area() float64 {
+	return 3.14 * c.radius * c.radius
+}
@@ -0,0 +1,8 @@
+package pkg
+
+func render(name string, bold, italic bool) string {
+	return name
+}
+
+func start(tls bool, port int, verbose bool, debug bool) { // MATCH /function start has 3 boolean parameters, consider using an options struct or named constants instead/
+}
@@ -0,0 +1,16 @@
+package pkg
+
+func render(name string, bold, italic bool) string { // MATCH /function render has 2 boolean parameters, consider using an options struct or named constants instead/
+	return name
+}
+
+func (s *server) start(tls bool, port int, verbose bool, debug bool) { // MATCH /function start has 3 boolean parameters, consider using an options struct or named constants instead/
+}
+
+func external(bool, bool) // MATCH /function external has 2 boolean parameters, consider using an options struct or named constants instead/
+
+func single(enabled bool) {}
+
+func noBools(a, b int) {}
+
+type server struct{}
@@ -5,10 +5,10 @@ package foo
 
 type foo struct{}
 
-func (this foo) f1() { // MATCH /receiver name should be a reflection of its identity; don't use generic names such as "this" or "self"/
+func (this foo) f1() { // MATCH /receiver name should be a reflection of its identity; don't use the generic name "this", consider naming it f/
 }
 
-func (self foo) f2() { // MATCH /receiver name should be a reflection of its identity; don't use generic names such as "this" or "self"/
+func (self foo) f2() { // MATCH /receiver name should be a reflection of its identity; don't use the generic name "self", consider naming it f/
 }
 
 func (f foo) f3() {
@@ -0,0 +1,53 @@
+package pkg
+
+import (
+	"io"
+	"net/http"
+)
+
+func fetch(url string) ([]byte, error) {
+	resp, err := http.Get(url) // MATCH /the body of resp is never closed, add defer resp.Body.Close() to release the connection/
+	if err != nil {
+		return nil, err
+	}
+
+	return io.ReadAll(resp.Body)
+}
+
+func fetchClosed(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	return io.ReadAll(resp.Body)
+}
+
+func ping(client *http.Client, req *http.Request) {
+	_, _ = client.Do(req) // MATCH /the response returned by client.Do is discarded without closing its body, this leaks a connection/
+}
+
+func head(url string) *http.Response {
+	resp, _ := http.Head(url)
+	return resp
+}
+
+func closedInClosure(url string) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+}
+
+func closedElsewhere(url string) {
+	resp, _ := http.Get(url)
+	drain(resp)
+}
+
+func drain(resp *http.Response) {
+	defer resp.Body.Close()
+}
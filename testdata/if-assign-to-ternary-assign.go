@@ -0,0 +1,16 @@
+package fixtures
+
+func ternary(c bool, a, b int) int {
+	var x int
+	if c { // MATCH /x is assigned in both branches of the if-else, consider assigning b before the if and dropping the else/
+		x = a
+	} else {
+		x = b
+	}
+
+	if c {
+		return a
+	} else {
+		return x
+	}
+}
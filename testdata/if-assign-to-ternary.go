@@ -0,0 +1,75 @@
+package fixtures
+
+func ternary(c bool, a, b int, m map[string]int) int {
+	var x int
+	if c { // MATCH /x is assigned in both branches of the if-else, consider assigning b before the if and dropping the else/
+		x = a
+	} else {
+		x = b
+	}
+
+	if a > b { // MATCH /m["k"] is assigned in both branches of the if-else, consider assigning 0 before the if and dropping the else/
+		m["k"] = a
+	} else {
+		m["k"] = 0
+	}
+
+	if c {
+		x = a
+	} else {
+		x = compute() // side effects
+	}
+
+	if c {
+		x = a
+	} else {
+		x += b
+	}
+
+	if c {
+		x = a
+	} else {
+		m["k"] = b
+	}
+
+	if c {
+		x := a
+		_ = x
+	} else {
+		x := b
+		_ = x
+	}
+
+	if c {
+		x = a
+	} else if a > b {
+		x = b
+	}
+
+	var p *int
+	if p == nil {
+		p = &a
+	} else {
+		p = nil
+	}
+
+	if a < 0 {
+		a = -a
+	} else {
+		a = b
+	}
+
+	if c {
+		x = x + 1
+	} else {
+		x = 0
+	}
+
+	if c { // MATCH /both branches of the if-else return, consider dropping the else and ending with return b/
+		return a
+	} else {
+		return b
+	}
+}
+
+func compute() int { return 0 }
@@ -0,0 +1,38 @@
+package pkg
+
+type logger struct{}
+
+func (logger) Log(string)     {}
+func (*logger) Flush()        {}
+func (logger) prefix() string { return "" }
+
+type quiet struct{}
+
+func (quiet) silence() {}
+
+type closer interface {
+	Close() error
+}
+
+type Service struct {
+	logger // MATCH /exported struct Service embeds unexported type logger thus making its methods Flush, Log part of the public API/
+	quiet
+	name string
+}
+
+type Pool struct {
+	*logger // MATCH /exported struct Pool embeds unexported type logger thus making its methods Flush part of the public API/
+	closer  // MATCH /exported struct Pool embeds unexported type closer thus making its methods Close part of the public API/
+}
+
+func (*Pool) Log(string) {}
+
+type Wrapped struct {
+	Exported
+}
+
+type Exported struct{ logger } // MATCH /exported struct Exported embeds unexported type logger thus making its methods Flush, Log part of the public API/
+
+type internal struct {
+	logger
+}
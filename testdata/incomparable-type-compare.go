@@ -0,0 +1,43 @@
+package pkg
+
+type config struct {
+	name string
+	tags []string
+}
+
+type point struct{ x, y int }
+
+func compare(a, b config, p point, v any) bool {
+	var x, y any = a, b
+	if x == y { // MATCH /comparing x, that holds a value of the incomparable type config, will panic at runtime/
+		return true
+	}
+
+	if any(a) != any(b) { // MATCH /comparing any(a), that holds a value of the incomparable type config, will panic at runtime/
+		return false
+	}
+
+	if any(a) == any(p) { // different dynamic types are never equal
+		return false
+	}
+
+	var i interface{} = []int{1}
+	if i == nil {
+		return false
+	}
+	if v == i { // MATCH /comparing i, that holds a value of the incomparable type []int, will panic at runtime/
+		return false
+	}
+
+	seen := map[any]bool{}
+	seen[p] = true
+	seen[a] = true // MATCH /using a, that holds a value of the incomparable type config, as a map key will panic at runtime/
+
+	var q any = p
+	if q == any(point{}) {
+		return true
+	}
+
+	x = p
+	return x == y
+}
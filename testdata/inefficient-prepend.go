@@ -0,0 +1,52 @@
+package fixtures
+
+type item struct{ id int }
+
+func inefficientPrepend(ids []int, items []*item) []int {
+	var result []int
+	for _, id := range ids {
+		result = append([]int{id}, result...) // MATCH /prepending to result with append copies the whole slice at each iteration of the loop (quadratic if the slice grows); append the elements and reverse the slice once, or use another data structure/
+	}
+
+	var all []*item
+	for i := 0; i < len(items); i++ {
+		if items[i] != nil {
+			all = append([]*item{items[i], {id: i}}, (all)...) // MATCH /prepending to (all) with append copies the whole slice at each iteration of the loop (quadratic if the slice grows); append the elements and reverse the slice once, or use another data structure/
+		}
+	}
+
+	for len(ids) > 0 {
+		ids = append([]int{0}, ids[1:]...) // MATCH /prepending to ids[1:] with append copies the whole slice at each iteration of the loop (quadratic if the slice grows); append the elements and reverse the slice once, or use another data structure/
+	}
+
+	// not in a loop
+	result = append([]int{0}, result...)
+
+	for range ids {
+		result = append(result, 1)           // appending
+		copied := append([]int{}, result...) // copying
+		_ = append([]int{1}, 2, 3)           // not a prepend
+		_ = append([]int{1}, []int{2, 3}...) // MATCH /prepending to []int{2, 3} with append copies the whole slice at each iteration of the loop (quadratic if the slice grows); append the elements and reverse the slice once, or use another data structure/
+		_ = copied
+		defer func() {
+			result = append([]int{0}, result...) // in a closure, might be called once
+		}()
+	}
+
+	for result := range prependAll(ids, append([]int{0}, ids...)) { // the ranged expression is evaluated once
+		_ = result
+	}
+
+	return result
+}
+
+func prependAll(ids, prefix []int) []int {
+	return prefix
+}
+
+func shadowed(ids []int) {
+	append := func(prefix []int, ids ...int) []int { return prefix }
+	for range ids {
+		ids = append([]int{0}, ids...)
+	}
+}
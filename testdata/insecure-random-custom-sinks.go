@@ -0,0 +1,11 @@
+package pkg
+
+import "math/rand"
+
+func newInvitationCode() int {
+	return rand.Int() // MATCH /rand.Int from math/rand is used for newInvitationCode, use crypto/rand for security-sensitive values/
+}
+
+func generateToken() int {
+	return rand.Int()
+}
@@ -0,0 +1,31 @@
+package pkg
+
+import (
+	"math/rand"
+	"strconv"
+)
+
+func generateToken() string {
+	return strconv.Itoa(rand.Int()) // MATCH /rand.Int from math/rand is used for generateToken, use crypto/rand for security-sensitive values/
+}
+
+func hashPassword(pwd string, salt int64) string { return pwd }
+
+func register(pwd string) string {
+	n := rand.Int63() // MATCH /rand.Int63 from math/rand is used for hashPassword, use crypto/rand for security-sensitive values/
+	return hashPassword(pwd, n)
+}
+
+func session() {
+	sessionKey := rand.Uint64() // MATCH /rand.Uint64 from math/rand is used for sessionKey, use crypto/rand for security-sensitive values/
+	_ = sessionKey
+	storeNonce(rand.Int31()) // MATCH /rand.Int31 from math/rand is used for storeNonce, use crypto/rand for security-sensitive values/
+}
+
+func storeNonce(int32) {}
+
+func shuffle(items []string) {
+	rand.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+	delay := rand.Intn(100)
+	_ = delay
+}
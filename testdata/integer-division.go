@@ -0,0 +1,22 @@
+package fixtures
+
+import "time"
+
+type ratio float64
+
+func average(total, count int, values []int) float64 {
+	_ = float64(total) / float64(count)
+	_ = float64(3 / 2)
+	_ = total / count
+	_ = time.Duration(total / count)
+
+	_ = ratio(len(values) / count) // MATCH /integer division len(values) / count truncates its result before the conversion to ratio, consider converting the operands: ratio(len(values)) / ratio(count)/
+
+	_ = float64(total / 2.0) // MATCH /integer division total / 2.0 truncates its result before the conversion to float64, consider converting the operands: float64(total) / float64(2.0)/
+
+	return float64(total / count) // MATCH /integer division total / count truncates its result before the conversion to float64, consider converting the operands: float64(total) / float64(count)/
+}
+
+func percent(done, all int64) float32 {
+	return float32((done * 100) / all) // MATCH /integer division (done * 100) / all truncates its result before the conversion to float32, consider converting the operands: float32(done * 100) / float32(all)/
+}
@@ -0,0 +1,18 @@
+package fixtures
+
+import "fmt"
+
+func process(items []string) {
+	for _, item := range items {
+		go func() {
+			fmt.Println(item)
+		}()
+	}
+
+	var item string
+	for _, item = range items {
+		go func() { // MATCH /goroutine captures the loop variable(s) item, it may see the values of later iterations; pass them as arguments of the closure/
+			fmt.Println(item)
+		}()
+	}
+}
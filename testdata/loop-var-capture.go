@@ -0,0 +1,45 @@
+package fixtures
+
+import (
+	"fmt"
+	"os"
+	"sync"
+)
+
+func process(items []string, files []*os.File) {
+	var wg sync.WaitGroup
+	for _, item := range items {
+		wg.Add(1)
+		go func() { // MATCH /goroutine captures the loop variable(s) item, it may see the values of later iterations; pass them as arguments of the closure/
+			defer wg.Done()
+			fmt.Println(item)
+		}()
+	}
+	wg.Wait()
+
+	for i, item := range items {
+		go func(item string) { // MATCH /goroutine captures the loop variable(s) i, it may see the values of later iterations; pass them as arguments of the closure/
+			fmt.Println(i, item)
+		}(item)
+	}
+
+	for i := 0; i < len(items); i++ {
+		go fmt.Println(items[i]) // arguments are evaluated immediately
+		go func(i int) {
+			fmt.Println(items[i])
+		}(i)
+	}
+
+	for _, f := range files {
+		defer func() { // MATCH /deferred closure captures the loop variable(s) f, all the deferred calls will see their last value; pass them as arguments of the closure/
+			f.Close()
+		}()
+	}
+
+	for _, item := range items {
+		item := item
+		go func() {
+			fmt.Println(item)
+		}()
+	}
+}
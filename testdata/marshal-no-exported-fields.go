@@ -0,0 +1,55 @@
+package pkg
+
+import (
+	"encoding/json"
+	"os"
+	"time"
+)
+
+type secret struct {
+	user     string
+	password string
+}
+
+type User struct {
+	Name  string
+	email string
+}
+
+type base struct {
+	ID int
+}
+
+type derived struct {
+	base
+	hidden bool
+}
+
+type custom struct {
+	value int
+}
+
+func (c custom) MarshalJSON() ([]byte, error) { return nil, nil }
+
+type wrapper struct {
+	t time.Time
+}
+
+type stamped struct {
+	time.Time
+}
+
+func marshal() {
+	json.Marshal(secret{}) // MATCH /secret{} has no exported fields, marshaling it to JSON will produce an empty object/
+	s := &secret{}
+	json.MarshalIndent(s, "", " ")       // MATCH /s has no exported fields, marshaling it to JSON will produce an empty object/
+	json.NewEncoder(os.Stdout).Encode(s) // MATCH /s has no exported fields, marshaling it to JSON will produce an empty object/
+	json.Marshal(wrapper{})              // MATCH /wrapper{} has no exported fields, marshaling it to JSON will produce an empty object/
+	json.Marshal(User{})
+	json.Marshal(derived{})
+	json.Marshal(custom{})
+	json.Marshal(stamped{})
+	json.Marshal(struct{}{})
+	json.Marshal(map[string]int{})
+	json.Unmarshal(nil, s)
+}
@@ -0,0 +1,32 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"testing"
+)
+
+func report(err error, t *testing.T) error {
+	msg := fmt.Sprintf("loading failed: %w", err) // MATCH /the %w verb is only supported by fmt.Errorf, fmt.Sprintf will print %!w(...) instead; use %v/
+	log.Printf("loading failed: %w", err)         // MATCH /the %w verb is only supported by fmt.Errorf, log.Printf will print %!w(...) instead; use %v/
+	fmt.Fprintf(os.Stderr, "failed: %+w\n", err)  // MATCH /the %w verb is only supported by fmt.Errorf, fmt.Fprintf will print %!w(...) instead; use %v/
+	t.Errorf("unexpected error %w", err)          // MATCH /the %w verb is only supported by fmt.Errorf, t.Errorf will print %!w(...) instead; use %v/
+
+	fmt.Printf("100%% %v\n", err)
+	fmt.Printf("100%%w\n")
+	log.Print("%w")
+	_ = msg
+
+	logger := log.New(os.Stderr, "", 0)
+	logger.Fatalf("loading failed: %w", err) // MATCH /the %w verb is only supported by fmt.Errorf, logger.Fatalf will print %!w(...) instead; use %v/
+
+	_ = wrapf(err, "loading failed: %w", err)
+
+	return fmt.Errorf("loading failed: %w", errors.Join(err, os.ErrNotExist))
+}
+
+func wrapf(err error, format string, args ...any) error {
+	return fmt.Errorf(format, args...)
+}
@@ -0,0 +1,9 @@
+package pkg
+
+import "testing"
+
+func assertEqual(t *testing.T, got, want int) { // MATCH /test helper assertEqual reports failures with t.Errorf but does not call t.Helper(), failures will be reported at the wrong line/
+	if got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
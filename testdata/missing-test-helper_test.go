@@ -0,0 +1,35 @@
+package pkg
+
+import "testing"
+
+func assertEqual(t *testing.T, got, want int) { // MATCH /test helper assertEqual reports failures with t.Errorf but does not call t.Helper(), failures will be reported at the wrong line/
+	if got != want {
+		t.Errorf("got %d, want %d", got, want)
+	}
+}
+
+func assertNoError(tb testing.TB, err error) {
+	tb.Helper()
+	if err != nil {
+		tb.Fatal(err)
+	}
+}
+
+func mustOpen(tb testing.TB, name string) { // MATCH /test helper mustOpen reports failures with tb.Fatalf but does not call tb.Helper(), failures will be reported at the wrong line/
+	if name == "" {
+		tb.Fatalf("empty name")
+	}
+}
+
+func runAll(t *testing.T) {
+	t.Run("sub", func(t *testing.T) {
+		t.Error("failure")
+	})
+}
+
+func TestSum(t *testing.T) {
+	if 1+1 != 2 {
+		t.Errorf("wrong sum")
+	}
+	assertEqual(t, 2, 1+1)
+}
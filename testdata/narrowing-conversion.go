@@ -0,0 +1,67 @@
+package fixtures
+
+import "math"
+
+type ID int64
+
+var global int64
+
+var narrowedGlobal = int32(global) // MATCH /conversion from int64 to int32 may lose data, check the bounds of the value before converting it/
+
+func conversions(a int64, b int, f float64, id ID, small int8) {
+	_ = int32(a) // MATCH /conversion from int64 to int32 may lose data, check the bounds of the value before converting it/
+	_ = uint8(b) // MATCH /conversion from int to uint8 may lose data, check the bounds of the value before converting it/
+	_ = float32(f) // MATCH /conversion from float64 to float32 may lose data, check the bounds of the value before converting it/
+	_ = int16(id) // MATCH /conversion from int64 to int16 may lose data, check the bounds of the value before converting it/
+
+	_ = int64(b)
+	_ = int(a)
+	_ = uint64(a)
+	_ = int64(small)
+	_ = float64(f)
+	_ = int32(42)
+	_ = int32(math.MaxInt32)
+	_ = int(f)
+}
+
+func masked(a int64, b uint) {
+	_ = uint8(a & 0xff)
+	_ = uint8(b % 256)
+	_ = int8(a % 128)
+	_ = uint8((a >> 8) & 0xff)
+	_ = int8(a & 0xff) // MATCH /conversion from int64 to int8 may lose data, check the bounds of the value before converting it/
+	_ = uint8(b % 257) // MATCH /conversion from uint to uint8 may lose data, check the bounds of the value before converting it/
+}
+
+func guarded(a int64, b int) int32 {
+	if b > math.MaxUint16 {
+		return 0
+	}
+	_ = uint16(b)
+
+	_ = int32(a) // MATCH /conversion from int64 to int32 may lose data, check the bounds of the value before converting it/
+	if a < math.MinInt32 || a > math.MaxInt32 {
+		return 0
+	}
+
+	return int32(a)
+}
+
+func inLiteral(values []int) {
+	_ = func() {
+		for _, v := range values {
+			_ = int8(v) // MATCH /conversion from int to int8 may lose data, check the bounds of the value before converting it/
+		}
+	}
+}
+
+func roundTrip(a int64, b int) (int32, int8) {
+	if a != int64(int32(a)) {
+		return 0, 0
+	}
+	if int(int8(b)) == b {
+		return int32(a), int8(b)
+	}
+
+	return int32(a), 0
+}
@@ -0,0 +1,76 @@
+package fixtures
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+)
+
+type Config struct {
+	Name string
+	Tags [2]string
+}
+
+func (c Config) String() string { return c.Name }
+
+func (c *Config) Validate() error { return nil }
+
+func Name(c *Config) string {
+	return c.Name // MATCH /parameter c of the exported function Name is dereferenced without being checked for nil, validate it (e.g. if c == nil { return ... })/
+}
+
+func Reset(dst *Config) {
+	*dst = Config{} // MATCH /parameter dst of the exported function Reset is dereferenced without being checked for nil, validate it (e.g. if dst == nil { return ... })/
+}
+
+func Describe(c *Config) string {
+	if len(c.Tags) > 0 { // MATCH /parameter c of the exported function Describe is dereferenced without being checked for nil, validate it (e.g. if c == nil { return ... })/
+		return (c).String()
+	}
+	return ""
+}
+
+func Stringer(c *Config) string {
+	return c.String() // MATCH /parameter c of the exported function Stringer is dereferenced without being checked for nil, validate it (e.g. if c == nil { return ... })/
+}
+
+func Checked(c *Config) (string, error) {
+	if c == nil {
+		return "", errors.New("nil config")
+	}
+	return c.Name, nil
+}
+
+func Delegated(c *Config) error {
+	if err := c.Validate(); err != nil { // the method handles nil receivers
+		return err
+	}
+	return nil
+}
+
+func Passed(c *Config, buf *bytes.Buffer) {
+	use(c)
+	buf.WriteString(c.Name)
+}
+
+func Ignored(r *http.Request) string {
+	return r.Method
+}
+
+func name(c *Config) string {
+	return c.Name // unexported
+}
+
+type internal struct{}
+
+func (internal) Name(c *Config) string {
+	return c.Name // method of an unexported type
+}
+
+func Closure(c *Config) func() string {
+	return func() string {
+		return c.Name // closures might be called later
+	}
+}
+
+func use(*Config) {}
@@ -0,0 +1,32 @@
+package fixtures
+
+import (
+	"context"
+	stdctx "context"
+)
+
+type Server struct {
+	ctx  context.Context // MATCH /field ctx of Server stores a context.Context, pass the context to each method call instead/
+	name string
+}
+
+type Worker struct {
+	stdctx.Context // MATCH /embedded context.Context of Worker stores a context.Context, pass the context to each method call instead/
+}
+
+type Job struct {
+	options struct {
+		parent context.Context // MATCH /field parent of Job stores a context.Context, pass the context to each method call instead/
+	}
+	cancel context.CancelFunc
+}
+
+type requestScope struct {
+	ctx context.Context
+}
+
+func handle(ctx context.Context) {
+	type local struct {
+		ctx context.Context // MATCH /field ctx of local stores a context.Context, pass the context to each method call instead/
+	}
+}
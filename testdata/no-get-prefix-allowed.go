@@ -0,0 +1,19 @@
+package fixtures
+
+type Named interface {
+	GetHeader() string
+}
+
+type message struct{}
+
+func (m message) GetHeader() string { // implements Named
+	return ""
+}
+
+func (m message) GetBody() string { // MATCH /getter GetBody should not have a Get prefix, consider renaming it Body/
+	return ""
+}
+
+func (m message) GetID() string { // allowed by configuration
+	return ""
+}
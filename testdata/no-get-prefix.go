@@ -0,0 +1,48 @@
+package fixtures
+
+type User struct {
+	name  string
+	Email string
+}
+
+func (u *User) GetName() string { // MATCH /getter GetName should not have a Get prefix, consider renaming it Name/
+	return u.name
+}
+
+func (u *User) GetEmail() string { // the name without prefix is used by a field
+	return u.Email
+}
+
+func (u User) GetAge() int { // MATCH /getter GetAge should not have a Get prefix, consider renaming it Age/
+	return 0
+}
+
+func (u *User) GetPermission(scope string) bool { // has a parameter
+	return false
+}
+
+func (u *User) GetBoth() (string, error) { // several results
+	return "", nil
+}
+
+func (u *User) Getaway() string {
+	return ""
+}
+
+func (u *User) Get() string {
+	return ""
+}
+
+func GetUser() *User { // not a method
+	return nil
+}
+
+type Named interface {
+	GetHeader() string
+}
+
+type message struct{}
+
+func (m message) GetHeader() string { // MATCH /getter GetHeader should not have a Get prefix, consider renaming it Header/
+	return ""
+}
@@ -0,0 +1,5 @@
+package fixtures
+
+func init() {
+	panic("allowed for this package")
+}
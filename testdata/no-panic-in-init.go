@@ -0,0 +1,43 @@
+package fixtures
+
+import (
+	"errors"
+	stdlog "log"
+	"os"
+)
+
+var config map[string]string
+
+func init() {
+	if err := load(); err != nil {
+		panic(err) // MATCH /calls to panic in init functions crash the program at import time, move the failing initialization to an explicit function returning an error/
+	}
+
+	if config == nil {
+		stdlog.Fatalf("no config") // MATCH /calls to stdlog.Fatalf in init functions crash the program at import time, move the failing initialization to an explicit function returning an error/
+	}
+
+	if len(config) > 10 {
+		os.Exit(1) // MATCH /calls to os.Exit in init functions crash the program at import time, move the failing initialization to an explicit function returning an error/
+	}
+
+	go func() {
+		panic("not at initialization")
+	}()
+}
+
+func init() {
+	defer recover()
+	stdlog.Println("ok")
+}
+
+func load() error {
+	panic("not in init")
+	return errors.New("failure")
+}
+
+type T struct{}
+
+func (T) init() {
+	os.Exit(1)
+}
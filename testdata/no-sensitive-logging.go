@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+	"os"
+)
+
+type User struct {
+	Name     string
+	Password string `json:"-" sensitive:"true"`
+}
+
+type Account struct {
+	ID    int
+	Owner *User
+}
+
+type Token struct {
+	Value string
+}
+
+type Session struct {
+	Token Token
+}
+
+type RedactedUser struct {
+	Password string `sensitive:"true"`
+}
+
+func (RedactedUser) String() string { return "[redacted]" }
+
+func logs(u User, a *Account, t Token, s Session, r RedactedUser, logger *slog.Logger) {
+	fmt.Printf("user: %+v\n", u)          // MATCH /fmt.Printf logs u, whose field Password is sensitive; redact it or implement fmt.Stringer to control its output/
+	log.Println("password", u.Password)   // MATCH /log.Println logs u.Password, a field tagged as sensitive; redact it/
+	logger.Info("account", "account", a)  // MATCH /logger.Info logs a, whose field Owner.Password is sensitive; redact it or implement fmt.Stringer to control its output/
+	fmt.Fprintln(os.Stderr, t)            // MATCH /fmt.Fprintln logs t, of the sensitive type fixtures.Token; redact it or implement fmt.Stringer to control its output/
+	_ = fmt.Sprint(s)                     // MATCH /fmt.Sprint logs s, whose field Token is of the sensitive type fixtures.Token; redact it or implement fmt.Stringer to control its output/
+	_ = fmt.Errorf("invalid user %v", &u) // MATCH /fmt.Errorf logs &u, whose field Password is sensitive; redact it or implement fmt.Stringer to control its output/
+
+	fmt.Println(u.Name, a.ID)
+	fmt.Println(r)
+	_ = fmt.Sprintf("%d", len(u.Password))
+	println(u.Password)
+}
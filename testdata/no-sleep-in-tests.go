@@ -0,0 +1,7 @@
+package fixtures
+
+import "time"
+
+func backoff() {
+	time.Sleep(time.Second)
+}
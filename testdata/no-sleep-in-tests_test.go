@@ -0,0 +1,25 @@
+package fixtures
+
+import (
+	"testing"
+	"time"
+)
+
+const settle = 5 * time.Millisecond
+
+func TestSleeps(t *testing.T) {
+	time.Sleep(time.Millisecond)
+	time.Sleep(10 * time.Millisecond)
+	time.Sleep(settle)
+	time.Sleep(2 * settle)
+
+	time.Sleep(11 * time.Millisecond) // MATCH /time.Sleep in tests makes them slow and flaky, synchronize with channels, sync.WaitGroup or polling instead/
+	time.Sleep(time.Second)           // MATCH /time.Sleep in tests makes them slow and flaky, synchronize with channels, sync.WaitGroup or polling instead/
+
+	d := time.Millisecond
+	time.Sleep(d) // MATCH /time.Sleep in tests makes them slow and flaky, synchronize with channels, sync.WaitGroup or polling instead/
+
+	go func() {
+		time.Sleep(time.Minute) // MATCH /time.Sleep in tests makes them slow and flaky, synchronize with channels, sync.WaitGroup or polling instead/
+	}()
+}
@@ -0,0 +1,28 @@
+package pkg
+
+import (
+	"fmt"
+	t "time"
+)
+
+func poll() {
+	for now := range t.Tick(t.Second) { // MATCH /the ticker created by time.Tick can not be stopped, use time.NewTicker and call its Stop method when done/
+		fmt.Println(now)
+	}
+}
+
+func pollTicker() {
+	ticker := t.NewTicker(t.Second)
+	defer ticker.Stop()
+	for now := range ticker.C {
+		fmt.Println(now)
+	}
+}
+
+type clock struct{}
+
+func (clock) Tick() {}
+
+func local(time clock) {
+	time.Tick()
+}
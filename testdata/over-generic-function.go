@@ -0,0 +1,39 @@
+package pkg
+
+func Max[T int | float64](a, b T) T { // MATCH /generic function Max is only instantiated with [int] in its package, consider making it non-generic/
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func Map[T, U any](s []T, f func(T) U) []U {
+	result := make([]U, 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v))
+	}
+	return result
+}
+
+func Filter[T any](s []T, keep func(T) bool) []T { // MATCH /generic function Filter is only instantiated with [string] in its package, consider making it non-generic/
+	var result []T
+	for _, v := range s {
+		if keep(v) {
+			result = append(result, v)
+		}
+	}
+	return result
+}
+
+func Reverse[T any](s []T) {}
+
+func first[T any](s []T) T { return s[0] }
+
+func use() {
+	_ = Max(1, 2)
+	_ = Max[int](3, 4)
+	_ = Map([]int{1}, func(i int) string { return "" })
+	_ = Map([]string{""}, func(s string) int { return 0 })
+	_ = Filter([]string{"a"}, func(s string) bool { return s != "" })
+	_ = first([]int{1})
+}
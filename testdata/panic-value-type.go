@@ -0,0 +1,42 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+type code int
+
+type state int
+
+func (s state) String() string { return "state" }
+
+func panics(x int) {
+	switch x {
+	case 0:
+		panic(42) // MATCH /panic with a value of type int, use a string, an error or a fmt.Stringer with a meaningful message instead/
+	case 1:
+		panic(code(1)) // MATCH /panic with a value of type pkg.code, use a string, an error or a fmt.Stringer with a meaningful message instead/
+	case 2:
+		panic([]string{"a"}) // MATCH /panic with a value of type []string, use a string, an error or a fmt.Stringer with a meaningful message instead/
+	case 3:
+		panic(nil) // MATCH /panic with a value of type untyped nil, use a string, an error or a fmt.Stringer with a meaningful message instead/
+	case 4:
+		panic("unexpected value")
+	case 5:
+		panic(errors.New("boom"))
+	case 6:
+		panic(fmt.Sprintf("unexpected %d", x))
+	case 7:
+		panic(state(1))
+	case 8:
+		panic(time.Second)
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			panic(r)
+		}
+	}()
+}
@@ -0,0 +1,15 @@
+package pkg
+
+import "io"
+
+type shape interface {
+	Area() float64
+}
+
+func readAll(r *io.Reader) ([]byte, error) { // MATCH /parameter of type *io.Reader is a pointer to the interface io.Reader; use io.Reader instead, values like *os.File, *bytes.Buffer or net.Conn can be passed to it directly/
+	return io.ReadAll(*r)
+}
+
+func area(s *shape) float64 { // MATCH /parameter of type *shape is a pointer to an interface, interface values already hold a reference to their dynamic value; use shape instead/
+	return (*s).Area()
+}
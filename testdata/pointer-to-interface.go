@@ -0,0 +1,32 @@
+package pkg
+
+import (
+	"fmt"
+	"io"
+)
+
+type shape interface {
+	Area() float64
+}
+
+func area(s *shape) float64 { // MATCH /parameter of type *shape is a pointer to an interface, interface values already hold a reference to their dynamic value; use shape instead/
+	return (*s).Area()
+}
+
+func copyAll(w *io.Writer, r io.Reader) error { // MATCH /parameter of type *io.Writer is a pointer to an interface, interface values already hold a reference to their dynamic value; use io.Writer instead/
+	_, err := io.Copy(*w, r)
+	return err
+}
+
+var printer = func(s *fmt.Stringer) {} // MATCH /parameter of type *fmt.Stringer is a pointer to an interface, interface values already hold a reference to their dynamic value; use fmt.Stringer instead/
+
+type point struct{ x, y int }
+
+func move(p *point, e *error) {}
+
+func decode(data []byte, out *shape) error {
+	*out = nil
+	return nil
+}
+
+func generic[T any](v *T) {}
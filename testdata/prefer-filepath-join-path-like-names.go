@@ -0,0 +1,10 @@
+package pkg
+
+func paths(dir, file, name string, filePath string) []string {
+	return []string{
+		dir + "/" + file,      // MATCH /path built by concatenating strings, use filepath.Join instead/
+		filePath + "/" + name, // MATCH /path built by concatenating strings, use filepath.Join instead/
+		"/usr/local/" + name,
+		name + "/" + name,
+	}
+}
@@ -0,0 +1,38 @@
+package pkg
+
+import "os"
+
+const base = "/etc"
+
+const configPath = base + "/revive.toml"
+
+type project struct {
+	root string
+}
+
+type xmlName struct {
+	Local string
+}
+
+func paths(dir, file, name, scheme, host, line string, p project, n xmlName) []string {
+	return []string{
+		dir + "/" + file,                          // MATCH /path built by concatenating strings, use filepath.Join instead/
+		p.root + "/" + name + ".go",               // MATCH /path built by concatenating strings, use filepath.Join instead/
+		os.TempDir() + "/" + name,                 // MATCH /path built by concatenating strings, use filepath.Join instead/
+		"https://example.com/" + dir + "/" + name, // MATCH /URL path built by concatenating strings, use path.Join instead/
+		"https://example.com/search?q=" + name,    // a query, not a path
+		"https://" + host + "/" + name,            // a host, then a path
+		"http://" + host,                          // a scheme and a host, not a path
+		scheme + "://" + host,                     // a scheme and a host, not a path
+		scheme + "://" + host + "/api",
+		"/usr/local/" + name, // not only a separator
+		p.root + "/src",
+		"//" + line,          // a comment
+		"</" + n.Local + ">", // markup
+		name + "/AppendTo",   // a subtest name
+		"hello " + name,
+		dir + file,
+		name + ": /",
+		configPath,
+	}
+}
@@ -0,0 +1,103 @@
+package fixtures
+
+import "net/http"
+
+type state int
+
+const (
+	idle state = iota
+	running
+	stopped
+)
+
+func preferSwitch(x int, s state, method string, r *http.Request, m map[string]int) {
+	if x == 1 { // MATCH /this if-else-if chain compares x against constants in 3 conditions, use a switch on x instead/
+		println("one")
+	} else if x == 2 {
+		println("two")
+	} else if 3 == x {
+		println("three")
+	}
+
+	if s == idle { // MATCH /this if-else-if chain compares s against constants in 3 conditions, use a switch on s instead/
+		println("idle")
+	} else if s == running || s == stopped {
+		println("not idle")
+	} else if (s == 42) {
+		println("unknown")
+	} else {
+		println("other")
+	}
+
+	if r.Method == http.MethodGet { // MATCH /this if-else-if chain compares r.Method against constants in 3 conditions, use a switch on r.Method instead/
+		println("get")
+	} else if r.Method == http.MethodPost {
+		println("post")
+	} else if r.Method == "PATCH" {
+		println("patch")
+	} else if x > 3 {
+		println("other")
+	}
+
+	// too short
+	if x == 1 {
+		println("one")
+	} else if x == 2 {
+		println("two")
+	}
+
+	// different expressions
+	if x == 1 {
+		println("one")
+	} else if method == "GET" {
+		println("get")
+	} else if x == 3 {
+		println("three")
+	}
+
+	// not constants
+	if method == r.Method {
+		println("same")
+	} else if method == r.Host {
+		println("host")
+	} else if method == r.Proto {
+		println("proto")
+	}
+
+	// duplicated constants
+	if x == 1 {
+		println("one")
+	} else if x == 2 {
+		println("two")
+	} else if x == 1 {
+		println("never")
+	}
+
+	// evaluated at each comparison
+	if m[method] == 1 {
+		println("one")
+	} else if len(method) == 2 {
+		println("two")
+	} else if len(method) == 3 {
+		println("three")
+	}
+
+	// the chain starts after the first condition
+	if x > 10 {
+		println("big")
+	} else if method == "GET" { // MATCH /this if-else-if chain compares method against constants in 3 conditions, use a switch on method instead/
+		println("get")
+	} else if method == "POST" {
+		println("post")
+	} else if method == "PUT" {
+		println("put")
+	}
+
+	if y := x * 2; y == 2 { // MATCH /this if-else-if chain compares y against constants in 3 conditions, use a switch on y instead/
+		println("one")
+	} else if y == 4 {
+		println("two")
+	} else if y == 6 {
+		println("three")
+	}
+}
@@ -0,0 +1,20 @@
+package pkg
+
+import "strconv"
+
+const endpoint = "https://example.com/search"
+
+const defaultQuery = endpoint + "?q=revive"
+
+func urls(query string, page int, base string) []string {
+	return []string{
+		"https://example.com/search?q=" + query,                  // MATCH /URL query built by concatenating strings, use url.Values to properly escape its parameters/
+		endpoint + "?q=" + query + "&page=" + strconv.Itoa(page), // MATCH /URL query built by concatenating strings, use url.Values to properly escape its parameters/
+		base + "?sort=asc&filter=" + query,                       // MATCH /URL query built by concatenating strings, use url.Values to properly escape its parameters/
+		endpoint + "?" + query,
+		endpoint + "?q=gopher",
+		defaultQuery,
+		"a = " + query,
+		"key=" + query,
+	}
+}
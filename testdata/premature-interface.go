@@ -0,0 +1,35 @@
+package fixtures
+
+type Store interface { // MATCH /interface Store has a single implementation (sqlStore) in its package, consider defining it where it is consumed instead/
+	Get(key string) (string, error)
+}
+
+type sqlStore struct{}
+
+func (s *sqlStore) Get(key string) (string, error) { return "", nil }
+
+type Shape interface {
+	Area() float64
+}
+
+type square struct{}
+
+func (square) Area() float64 { return 0 }
+
+type circle struct{}
+
+func (circle) Area() float64 { return 0 }
+
+type Closer interface { // no implementation in the package
+	Close() error
+}
+
+type Any interface{}
+
+type notifier interface { // unexported
+	Notify()
+}
+
+type mailer struct{}
+
+func (mailer) Notify() {}
@@ -0,0 +1,15 @@
+package pkg
+
+type queue struct {
+	items chan string
+}
+
+func (q *queue) drain() {
+	for range q.items { // MATCH /range over channel q.items that is never closed in this package, the loop might block forever/
+	}
+}
+
+func consume(in <-chan int) {
+	for range in {
+	}
+}
@@ -0,0 +1,72 @@
+package pkg
+
+import "context"
+
+type worker struct {
+	jobs    chan int
+	results chan int
+	done    chan struct{}
+}
+
+func (w *worker) run() {
+	for j := range w.jobs { // MATCH /range over channel w.jobs that is never closed in this package, the loop might block forever/
+		w.results <- j
+	}
+}
+
+func (w *worker) collect() int {
+	total := 0
+	for r := range w.results {
+		total += r
+	}
+	return total
+}
+
+func (w *worker) stop() {
+	close(w.results)
+}
+
+func consume(in <-chan int) int {
+	total := 0
+	for v := range in { // MATCH /range over channel in that is never closed in this package, the loop might block forever/
+		total += v
+	}
+	return total
+}
+
+func consumeWithContext(ctx context.Context, in <-chan int) {
+	for v := range in {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			_ = v
+		}
+	}
+}
+
+func produceAndConsume() {
+	ch := make(chan int)
+	go func() {
+		ch <- 1
+	}()
+	for range ch {
+	}
+}
+
+func consumeClosed(out chan int) {
+	go func() {
+		defer close(out)
+		out <- 1
+	}()
+
+	for v := range out {
+		_ = v
+	}
+}
+
+func rangeSlice(values []int) {
+	for v := range values {
+		_ = v
+	}
+}
@@ -0,0 +1,29 @@
+package fixtures
+
+type multiError struct{}
+
+func (me multiError) Error() string { // MATCH /receiver name should be a reflection of its identity; don't use the generic name "me", consider naming it m/
+	return ""
+}
+
+func (this *multiError) Len() int { // MATCH /receiver name should be a reflection of its identity; don't use the generic name "this", consider naming it m/
+	return 0
+}
+
+type imageFile struct{}
+
+func (that imageFile) Close() error { // MATCH /receiver name should be a reflection of its identity; don't use the generic name "that", consider naming it i/
+	return nil
+}
+
+func (f imageFile) Name() string {
+	return ""
+}
+
+type HTTPServer struct{}
+
+func (self *HTTPServer) Shutdown() {} // MATCH /receiver name should be a reflection of its identity; don't use the generic name "self", consider naming it h/
+
+type Server struct{}
+
+func (s *Server) Start() {}
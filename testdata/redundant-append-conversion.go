@@ -0,0 +1,19 @@
+package pkg
+
+type bytes []byte
+
+func join(b []byte, s string, other []byte, runes []rune, custom bytes) []byte {
+	b = append(b, []byte(s)...)      // MATCH /unnecessary conversion, use append(b, s...) instead/
+	b = append(b, []byte(other)...)  // MATCH /unnecessary conversion, use append(b, other...) instead/
+	b = append(b, []byte(custom)...) // conversion from a named slice type
+	b = append(b, s...)
+	b = append(b, []byte(string(runes))...) // MATCH /unnecessary conversion, use append(b, string(runes)...) instead/
+	b = append(b, []byte(s)[1:]...)
+	r := append(runes, []rune(s)...)
+	_ = r
+	return b
+}
+
+func strings(a []string, b []string) []string {
+	return append(a, []string(b)...) // MATCH /unnecessary conversion, use append(a, b...) instead/
+}
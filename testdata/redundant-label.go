@@ -0,0 +1,81 @@
+package fixtures
+
+func redundantLabel(items [][]int, ch chan int) {
+outer: // MATCH /label outer is only used by redundant branch statements, remove it/
+	for _, item := range items {
+		if len(item) == 0 {
+			continue outer // MATCH /continue outer is redundant, the bare continue applies to the same loop; remove the label/
+		}
+		if item[0] < 0 {
+			break outer // MATCH /break outer is redundant, the bare break applies to the same loop; remove the label/
+		}
+	}
+
+rows:
+	for _, item := range items {
+		for _, v := range item {
+			if v < 0 {
+				continue rows
+			}
+			if v == 0 {
+				break rows
+			}
+		}
+	}
+
+loop:
+	for {
+		select {
+		case v := <-ch:
+			if v < 0 {
+				break loop // exits the loop, the bare break would exit the select
+			}
+		}
+	}
+
+cases:
+	switch len(items) {
+	case 0:
+		break cases // MATCH /break cases is redundant, the bare break applies to the same switch; remove the label/
+	case 1:
+		for range items {
+			break cases
+		}
+	}
+
+waiting: // MATCH /label waiting is only used by redundant branch statements, remove it/
+	select {
+	case <-ch:
+		break waiting // MATCH /break waiting is redundant, the bare break applies to the same select; remove the label/
+	default:
+	}
+
+scan: // MATCH /label scan is only used by redundant branch statements, remove it/
+	for i := 0; i < len(items); i++ {
+		switch len(items[i]) {
+		case 0:
+			continue scan // MATCH /continue scan is redundant, the bare continue applies to the same loop; remove the label/
+		}
+	}
+
+retry:
+	for i := 0; i < 3; i++ {
+		if i == 1 {
+			goto retry
+		}
+		if i == 2 {
+			continue retry // MATCH /continue retry is redundant, the bare continue applies to the same loop; remove the label/
+		}
+	}
+
+each: // MATCH /label each is only used by redundant branch statements, remove it/
+	for range items {
+		func() {
+		inner: // MATCH /label inner is only used by redundant branch statements, remove it/
+			for {
+				break inner // MATCH /break inner is redundant, the bare break applies to the same loop; remove the label/
+			}
+		}()
+		continue each // MATCH /continue each is redundant, the bare continue applies to the same loop; remove the label/
+	}
+}
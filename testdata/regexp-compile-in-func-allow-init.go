@@ -0,0 +1,13 @@
+package pkg
+
+import "regexp"
+
+var initRE *regexp.Regexp
+
+func init() {
+	initRE = regexp.MustCompile(`^a`)
+}
+
+func match(s string) bool {
+	return regexp.MustCompile(`^[a-z]+$`).MatchString(s) // MATCH /regexp.MustCompile is called each time match is executed, move the compiled regular expression to a package-level variable/
+}
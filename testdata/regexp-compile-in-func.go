@@ -0,0 +1,35 @@
+package pkg
+
+import "regexp"
+
+var wordRE = regexp.MustCompile(`\w+`)
+
+var lazyRE = func() *regexp.Regexp {
+	return regexp.MustCompile(`\d+`)
+}()
+
+var initRE *regexp.Regexp
+
+func init() {
+	initRE = regexp.MustCompile(`^a`) // MATCH /regexp.MustCompile is called each time init is executed, move the compiled regular expression to a package-level variable/
+}
+
+func match(s string) bool {
+	re := regexp.MustCompile(`^[a-z]+$`) // MATCH /regexp.MustCompile is called each time match is executed, move the compiled regular expression to a package-level variable/
+	return re.MatchString(s)
+}
+
+func (t *T) compile() error {
+	_, err := regexp.Compile("[") // MATCH /regexp.Compile is called each time compile is executed, move the compiled regular expression to a package-level variable/
+	f := func() {
+		regexp.MustCompilePOSIX("x") // MATCH /regexp.MustCompilePOSIX is called each time compile is executed, move the compiled regular expression to a package-level variable/
+	}
+	f()
+	return err
+}
+
+type T struct{}
+
+func dynamic(pattern string) (*regexp.Regexp, error) {
+	return regexp.Compile(pattern)
+}
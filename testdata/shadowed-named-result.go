@@ -0,0 +1,84 @@
+package fixtures
+
+import "errors"
+
+func compute() (int, error) { return 0, nil }
+
+func shadowed() (result int, err error) {
+	if true {
+		result, err := compute() // MATCH /this declaration shadows the named results result, err, assignments to the new variables do not set the results/
+		_ = result
+		_ = err
+	}
+
+	for i := 0; i < 3; i++ {
+		var err error // MATCH /this declaration shadows the named result err, assignments to the new variable do not set the result/
+		_ = err
+	}
+
+	defer func() {
+		if err := recover(); err != nil { // scoped to the if statement
+			_ = err
+		}
+	}()
+
+	return
+}
+
+func notShadowed() (result int, err error) {
+	result, err = compute()
+	n, err := compute() // err is reused
+	_ = n
+
+	if err != nil {
+		err = errors.New("wrapped")
+	}
+
+	f := func() (err error) { // a result of the literal
+		return nil
+	}
+	_ = f
+
+	return result, err
+}
+
+func unnamed() (int, error) {
+	if true {
+		result, err := compute()
+		return result, err
+	}
+	return 0, nil
+}
+
+func lookup(m map[string]int, k string) (v int, ok bool) {
+	if v, ok := m[k]; ok { // results are returned explicitly
+		return v, true
+	}
+	return 0, false
+}
+
+func annotated() (err error) {
+	defer func() {
+		if err != nil {
+			err = errors.New("annotated: " + err.Error())
+		}
+	}()
+
+	if true {
+		n, err := compute() // MATCH /this declaration shadows the named result err, assignments to the new variable do not set the result/
+		_ = n
+		_ = err
+	}
+	return nil
+}
+
+func bareReturnBefore() (n int, err error) {
+	if n < 0 {
+		return
+	}
+	if true {
+		n, err := compute()
+		return n, err
+	}
+	return 1, nil
+}
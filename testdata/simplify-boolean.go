@@ -0,0 +1,36 @@
+package fixtures
+
+func simplify(a, b bool, i, j int, s string, f float64) {
+	if !!a { // MATCH /!!a can be simplified to a/
+	}
+
+	if !(!(a && b)) { // MATCH /!(!(a && b)) can be simplified to (a && b)/
+	}
+
+	if !(i == j) { // MATCH /!(i == j) can be simplified to i != j/
+	}
+
+	if !(i != j) { // MATCH /!(i != j) can be simplified to i == j/
+	}
+
+	if !(i < j) { // MATCH /!(i < j) can be simplified to i >= j/
+	}
+
+	if !(i+1 >= j) { // MATCH /!(i+1 >= j) can be simplified to i+1 < j/
+	}
+
+	if !(s > "a") { // MATCH /!(s > "a") can be simplified to s <= "a"/
+	}
+
+	if !(f < 1.0) { // NaN
+	}
+
+	if !(f == 1.0) { // MATCH /!(f == 1.0) can be simplified to f != 1.0/
+	}
+
+	if !(a && b) {
+	}
+
+	if !a {
+	}
+}
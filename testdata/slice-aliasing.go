@@ -0,0 +1,31 @@
+package pkg
+
+type names []string
+
+func withDefault(values []int) []int {
+	return append(values, 0) // MATCH /returning the result of appending to the parameter values, the returned slice might share its backing array with the caller's slice/
+}
+
+func (n names) add(extra names, name string) names {
+	return append(extra, name) // MATCH /returning the result of appending to the parameter extra, the returned slice might share its backing array with the caller's slice/
+}
+
+func copied(values []int) []int {
+	result := make([]int, 0, len(values)+1)
+	return append(result, values...)
+}
+
+func fresh(values []int) []int {
+	return append([]int(nil), values...)
+}
+
+func variadic(values ...int) (int, []int) {
+	f := func(v []int) []int {
+		return append(v, 1) // MATCH /returning the result of appending to the parameter v, the returned slice might share its backing array with the caller's slice/
+	}
+	return len(values), f(values)
+}
+
+func notASlice(s string) []byte {
+	return append([]byte(s), '!')
+}
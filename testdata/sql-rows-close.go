@@ -0,0 +1,69 @@
+package pkg
+
+import (
+	"database/sql"
+)
+
+func names(db *sql.DB) ([]string, error) {
+	rows, err := db.Query("SELECT name FROM users") // MATCH /rows is never closed, add defer rows.Close() to release the database connection/
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		result = append(result, name)
+	}
+
+	return result, rows.Err()
+}
+
+func count(db *sql.DB) int {
+	rows, err := db.Query("SELECT id FROM users")
+	if err != nil {
+		return 0
+	}
+	defer rows.Close()
+
+	n := 0
+	for rows.Next() {
+		n++
+	}
+	return n
+}
+
+func closedInClosure(db *sql.DB) {
+	rows, _ := db.Query("SELECT id FROM users")
+	defer func() {
+		_ = rows.Close()
+	}()
+}
+
+func discarded(tx *sql.Tx) {
+	_, _ = tx.Query("DELETE FROM users RETURNING id") // MATCH /the rows returned by tx.Query are discarded without being closed, this leaks a database connection/
+}
+
+func returned(stmt *sql.Stmt) (*sql.Rows, error) {
+	rows, err := stmt.Query()
+	return rows, err
+}
+
+func passed(db *sql.DB) {
+	rows, _ := db.Query("SELECT id FROM users")
+	consume(rows)
+}
+
+func consume(rows *sql.Rows) {
+	defer rows.Close()
+}
+
+func declared(db *sql.DB) {
+	var rows, err = db.Query("SELECT id FROM users") // MATCH /rows is never closed, add defer rows.Close() to release the database connection/
+	_ = err
+	for rows.Next() {
+	}
+}
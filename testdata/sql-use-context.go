@@ -0,0 +1,55 @@
+package pkg
+
+import (
+	"context"
+	"database/sql"
+)
+
+func list(ctx context.Context, db *sql.DB) error {
+	rows, err := db.Query("SELECT * FROM users") // MATCH /db.Query ignores context cancellation, use QueryContext(ctx, ...) to support cancellation/
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	rows, err = db.QueryContext(ctx, "SELECT * FROM users")
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	return nil
+}
+
+func update(db *sql.DB) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.Exec("UPDATE users SET active = 1"); err != nil { // MATCH /tx.Exec ignores context cancellation, use ExecContext with a context.Context to support cancellation/
+		return err
+	}
+
+	stmt, err := tx.Prepare("DELETE FROM users WHERE id = ?") // MATCH /tx.Prepare ignores context cancellation, use PrepareContext with a context.Context to support cancellation/
+	if err != nil {
+		return err
+	}
+	_, err = stmt.Exec(1)
+
+	return err
+}
+
+func handler(ctx context.Context, db *sql.DB) {
+	go func() {
+		_ = db.QueryRow("SELECT 1") // MATCH /db.QueryRow ignores context cancellation, use QueryRowContext(ctx, ...) to support cancellation/
+	}()
+}
+
+type repository struct{}
+
+func (repository) Query(string) {}
+
+func custom(r repository) {
+	r.Query("SELECT 1")
+}
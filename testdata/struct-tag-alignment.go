@@ -0,0 +1,35 @@
+package pkg
+
+type aligned struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+
+	Description string `json:"description"`
+}
+
+type misaligned struct {
+	ID   int    `json:"id"`
+	Name string  `json:"name"` // MATCH /the tag of field Name is not aligned with the tag of the preceding field/
+	Age  int    `json:"age"`
+}
+
+type mixed struct {
+	ID   int    `json:"id"`
+
+	Name string	`json:"name"` // MATCH /the tag of field Name is preceded by tabs while other tags of the struct are preceded by spaces/
+}
+
+type groups struct {
+	ID int `json:"id"`
+	Embedded
+	LongerName string `json:"longer_name"`
+}
+
+type Embedded struct{}
+
+type withEmbedded struct {
+	Severity string
+	RuleURL  string `json:",omitempty"`
+	Embedded `json:",inline"`
+	Name     string `json:"name"`
+}
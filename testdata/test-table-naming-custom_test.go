@@ -0,0 +1,20 @@
+package pkg
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	tests := []struct { // MATCH /test table tests should be named cases/
+		a, b, want int
+	}{
+		{1, 2, 3},
+	}
+	for _, tt := range tests {
+		if tt.a+tt.b != tt.want {
+			t.Fail()
+		}
+	}
+
+	var cases = []struct{ a int }{{1}}
+	for range cases {
+	}
+}
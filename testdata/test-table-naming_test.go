@@ -0,0 +1,44 @@
+package pkg
+
+import "testing"
+
+func TestSum(t *testing.T) {
+	tests := []struct {
+		a, b, want int
+	}{
+		{1, 2, 3},
+	}
+	for _, tt := range tests {
+		if tt.a+tt.b != tt.want {
+			t.Fail()
+		}
+	}
+}
+
+func TestProduct(t *testing.T) {
+	data := []struct { // MATCH /test table data should be named testCases or tests/
+		a, b, want int
+	}{
+		{2, 3, 6},
+	}
+	for _, tc := range data {
+		if tc.a*tc.b != tc.want {
+			t.Fail()
+		}
+	}
+}
+
+func TestNotATable(t *testing.T) {
+	inputs := []struct{ a int }{{1}}
+	_ = inputs
+
+	values := []int{1, 2}
+	for range values {
+	}
+}
+
+func helper() {
+	data := []struct{ a int }{{1}}
+	for range data {
+	}
+}
@@ -0,0 +1,47 @@
+package pkg
+
+func Index[T any](s []T, v T) int { // MATCH /type parameter T is constrained by any but its values are compared with ==, use comparable/
+	for i, e := range s {
+		if any(e) == any(v) {
+			return i
+		}
+	}
+	return -1
+}
+
+func Sum[T any](s []T) float64 { // MATCH /type parameter T is constrained by any but it is only switched over numeric types, use a numeric constraint like ~int | ~float64/
+	var total float64
+	for _, e := range s {
+		switch v := any(e).(type) {
+		case int:
+			total += float64(v)
+		case float64:
+			total += v
+		}
+	}
+	return total
+}
+
+func Describe[T interface{}](v T) string {
+	switch any(v).(type) {
+	case int:
+		return "int"
+	case string:
+		return "string"
+	default:
+		return "other"
+	}
+}
+
+func Contains[T comparable](s []T, v T) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+func First[T any](s []T) T {
+	return s[0]
+}
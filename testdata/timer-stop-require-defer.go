@@ -0,0 +1,31 @@
+package pkg
+
+import (
+	"time"
+)
+
+func pollStopped(done chan struct{}) {
+	ticker := time.NewTicker(time.Second) // MATCH /ticker is never stopped with a deferred Stop(), its resources might leak/
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func timeout() {
+	timer := time.NewTimer(time.Minute)
+	defer timer.Stop()
+	<-timer.C
+}
+
+func timeoutInClosure() {
+	timer := time.NewTimer(time.Minute)
+	defer func() {
+		timer.Stop()
+	}()
+	<-timer.C
+}
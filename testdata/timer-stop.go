@@ -0,0 +1,40 @@
+package pkg
+
+import (
+	"fmt"
+	"time"
+)
+
+func poll() {
+	ticker := time.NewTicker(time.Second) // MATCH /ticker is never stopped with Stop(), its resources might leak/
+	for now := range ticker.C {
+		fmt.Println(now)
+	}
+}
+
+func pollStopped(done chan struct{}) {
+	ticker := time.NewTicker(time.Second)
+	for {
+		select {
+		case <-ticker.C:
+		case <-done:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+func timeout() {
+	timer := time.NewTimer(time.Minute)
+	defer timer.Stop()
+	<-timer.C
+}
+
+func discarded() {
+	_ = time.NewTimer(time.Minute) // MATCH /the result of time.NewTimer is discarded, it can not be stopped and its resources might leak/
+}
+
+func returned() *time.Ticker {
+	ticker := time.NewTicker(time.Second)
+	return ticker
+}
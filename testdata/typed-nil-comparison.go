@@ -0,0 +1,95 @@
+package pkg
+
+type parseError struct{ msg string }
+
+func (e *parseError) Error() string { return e.msg }
+
+func parse(input string) error {
+	var err *parseError
+	if input == "" {
+		err = &parseError{"empty input"}
+	}
+	return err
+}
+
+func validate(input string) (int, error) {
+	if input == "" {
+		return 0, &parseError{"empty input"}
+	}
+	return len(input), nil
+}
+
+func lookup(name string) *parseError { return nil }
+
+func find(name string) error {
+	return lookup(name)
+}
+
+func explicit(input string) error {
+	if input == "" {
+		return (*parseError)(nil)
+	}
+	return nil
+}
+
+var errEmpty = &parseError{"empty"}
+
+func sentinel(empty bool) error {
+	if empty {
+		return errEmpty
+	}
+	return nil
+}
+
+func asserted(err error) error {
+	if pe, ok := err.(*parseError); ok {
+		return pe
+	}
+	switch e := err.(type) {
+	case *parseError:
+		return e
+	}
+	return nil
+}
+
+func run(input string) {
+	if err := parse(input); err != nil { // MATCH /err might hold a nil *parseError coming from parse, an interface holding a nil pointer is not equal to nil/
+		panic(err)
+	}
+
+	if find(input) == nil { // results of calls are assumed not nil
+		return
+	}
+
+	if explicit(input) == nil { // MATCH /explicit(input) might hold a nil *parseError coming from explicit, an interface holding a nil pointer is not equal to nil/
+		return
+	}
+
+	if err := sentinel(input == ""); err != nil {
+		panic(err)
+	}
+
+	if err := asserted(nil); err != nil {
+		panic(err)
+	}
+
+	if _, err := validate(input); err != nil {
+		panic(err)
+	}
+
+	var p *parseError
+	var err error = p
+	if err != nil { // MATCH /err might hold a nil *parseError coming from p, an interface holding a nil pointer is not equal to nil/
+		panic(err)
+	}
+
+	err = parse(input)
+	err = nil
+	if err != nil {
+		panic(err)
+	}
+
+	if p != nil {
+		panic(p)
+	}
+}
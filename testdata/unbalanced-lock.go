@@ -0,0 +1,143 @@
+package pkg
+
+import (
+	"errors"
+	"sync"
+)
+
+type store struct {
+	mu    sync.RWMutex
+	items map[string]int
+}
+
+func (s *store) earlyReturn(k string) (int, error) {
+	s.mu.Lock()
+	v, ok := s.items[k]
+	if !ok {
+		return 0, errors.New("not found") // MATCH /s.mu still locked when returning, unlock it or defer its unlocking/
+	}
+	s.mu.Unlock()
+	return v, nil
+}
+
+func (s *store) balanced(k string) (int, error) {
+	s.mu.Lock()
+	v, ok := s.items[k]
+	if !ok {
+		s.mu.Unlock()
+		return 0, errors.New("not found")
+	}
+	s.mu.Unlock()
+	return v, nil
+}
+
+func (s *store) deferred(k string) int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if k == "" {
+		return 0
+	}
+	return s.items[k]
+}
+
+func (s *store) deferredClosure(k string) int {
+	s.mu.Lock()
+	defer func() {
+		s.mu.Unlock()
+	}()
+	return s.items[k]
+}
+
+func (s *store) wrongKind(k string) int {
+	s.mu.RLock()
+	defer s.mu.Unlock()
+	return s.items[k] // MATCH /s.mu still locked when returning, unlock it or defer its unlocking/
+}
+
+func (s *store) neverUnlocks(k string, v int) {
+	s.mu.Lock()
+	s.items[k] = v
+} // MATCH /s.mu still locked at the end of the function, unlock it or defer its unlocking/
+
+func (s *store) inSwitch(k string) int {
+	s.mu.Lock()
+	switch k {
+	case "a":
+		s.mu.Unlock()
+		return 1
+	case "b":
+		return 2 // MATCH /s.mu still locked when returning, unlock it or defer its unlocking/
+	default:
+		panic("unexpected")
+	}
+}
+
+func (s *store) inLoop(keys []string) int {
+	for _, k := range keys {
+		s.mu.Lock()
+		if k == "" {
+			continue
+		}
+		if v, ok := s.items[k]; ok {
+			return v // MATCH /s.mu still locked when returning, unlock it or defer its unlocking/
+		}
+		s.mu.Unlock()
+	}
+	return 0
+}
+
+func (s *store) conditional(lock bool) {
+	if lock {
+		s.mu.Lock()
+	}
+	s.items["x"] = 1
+	if lock {
+		s.mu.Unlock()
+	}
+}
+
+func (s *store) goroutine() {
+	s.mu.Lock()
+	go func() {
+		defer s.mu.Unlock()
+	}()
+	s.mu.Unlock()
+}
+
+type conn struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+}
+
+func (c *conn) lock() {
+	c.mu.Lock()
+	if c.cond == nil {
+		c.cond = sync.NewCond(&c.mu)
+	}
+}
+
+func (c *conn) acquire() { c.mu.Lock() }
+
+func (c *conn) release() { c.mu.Unlock() }
+
+func (c *conn) wait(ready func() bool) {
+	c.mu.Unlock()
+	for !ready() {
+	}
+	c.mu.Lock()
+}
+
+func (s *store) leakInLoop(keys []string) {
+	for _, k := range keys {
+		s.mu.Lock()
+		s.items[k]++
+	} // MATCH /s.mu still locked at the end of the loop iteration, unlock it before the next iteration/
+}
+
+func (s *store) lockedInLoop(keys []string) {
+	for i := 0; i < len(keys); i++ {
+		s.mu.Lock()
+		s.items[keys[i]]++
+		s.mu.Unlock()
+	}
+}
@@ -0,0 +1,12 @@
+package pkg
+
+func step() error { return nil }
+
+func twice() error { // MATCH /function twice returns the unwrapped error err from 2 different places, consider adding context to differentiate them/
+	err := step()
+	if err != nil {
+		return err
+	}
+	err = step()
+	return err
+}
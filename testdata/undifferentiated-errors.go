@@ -0,0 +1,86 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+)
+
+func step() error { return nil }
+
+func load() (int, error) { // MATCH /function load returns the unwrapped error err from 3 different places, consider adding context to differentiate them/
+	err := step()
+	if err != nil {
+		return 0, err
+	}
+	if err = step(); err != nil {
+		return 0, err
+	}
+	if err = step(); err != nil {
+		return 0, err
+	}
+	return 1, nil
+}
+
+func wrapped() error {
+	err := step()
+	if err != nil {
+		return fmt.Errorf("first step: %w", err)
+	}
+	if err = step(); err != nil {
+		return fmt.Errorf("second step: %w", err)
+	}
+	if err = step(); err != nil {
+		return err
+	}
+	return nil
+}
+
+var errSentinel = errors.New("sentinel")
+
+func sentinel(x int) error {
+	switch x {
+	case 1:
+		return errSentinel
+	case 2:
+		return errSentinel
+	}
+	return errSentinel
+}
+
+func withClosure() error {
+	f := func() error { // MATCH /function literal returns the unwrapped error err from 3 different places, consider adding context to differentiate them/
+		err := step()
+		if err != nil {
+			return err
+		}
+		if err = step(); err != nil {
+			return err
+		}
+		return err
+	}
+	return f()
+}
+
+func scoped() error { // MATCH /function scoped returns the unwrapped error err from 3 different places, consider adding context to differentiate them/
+	if err := step(); err != nil {
+		return err
+	}
+	if err := step(); err != nil {
+		return err
+	}
+	if err := step(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func notErrors(x int) int {
+	v := x
+	if x > 1 {
+		return v
+	}
+	if x > 2 {
+		return v
+	}
+	return v
+}
@@ -0,0 +1,25 @@
+package fixtures
+
+import (
+	"image"
+)
+
+type point struct {
+	X, Y int
+}
+
+type point3D struct {
+	X, Y, Z int
+}
+
+type vec3 struct {
+	X, Y, Z float64
+}
+
+func unkeyedStructLiteralConfigured() {
+	_ = point{1, 2}
+	_ = point3D{1, 2, 3} // MATCH /literal of the struct point3D has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = vec3{1, 2, 3}
+	_ = image.Point{1, 2}
+	_ = image.Rectangle{image.Point{0, 0}, image.Point{1, 1}}
+}
@@ -0,0 +1,46 @@
+package fixtures
+
+import (
+	"image"
+	"sync"
+)
+
+type point struct {
+	X, Y int
+}
+
+type segment struct {
+	From, To point
+}
+
+type pair[T any] struct {
+	First, Second T
+}
+
+type celsius struct{ degrees float64 }
+
+func unkeyedStructLiteral() {
+	_ = point{1, 2} // MATCH /literal of the struct point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = point{X: 1, Y: 2}
+	_ = point{}
+	_ = &point{3, 4} // MATCH /literal of the struct point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+
+	_ = image.Point{1, 2}                                            // MATCH /literal of the struct image.Point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = image.Rectangle{Min: image.Point{0, 0}, Max: image.Pt(1, 1)} // MATCH /literal of the struct image.Point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = sync.Mutex{}
+
+	_ = segment{ // MATCH /literal of the struct segment has unkeyed fields, name them (e.g. From: ...) to not depend on the order of the fields/
+		point{0, 0}, // MATCH /literal of the struct point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+		point{X: 1, Y: 1},
+	}
+	_ = []point{{1, 2}, {X: 3, Y: 4}} // MATCH /literal of the struct point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = []*point{{5, 6}}              // MATCH /literal of the struct point has unkeyed fields, name them (e.g. X: ...) to not depend on the order of the fields/
+	_ = map[string]point{"origin": {}}
+	_ = pair[string]{"a", "b"} // MATCH /literal of the struct pair has unkeyed fields, name them (e.g. First: ...) to not depend on the order of the fields/
+	_ = celsius{36.6}          // MATCH /literal of the struct celsius has unkeyed fields, name them (e.g. degrees: ...) to not depend on the order of the fields/
+
+	// anonymous structs are declared alongside their literals
+	_ = []struct{ in, out string }{{"a", "b"}}
+	_ = []int{1, 2}
+	_ = [2]int{1, 2}
+}
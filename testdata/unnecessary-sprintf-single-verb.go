@@ -0,0 +1,13 @@
+package fixtures
+
+import (
+	"fmt"
+	"net"
+)
+
+func formats(a, b string, ip net.IP) {
+	_ = fmt.Sprintf("%s", a)
+	_ = fmt.Sprintf("[%s]", a)
+	_ = fmt.Sprintf("%s/%s", ip, a)
+	_ = fmt.Sprintf("%s%s", a, b) // MATCH /fmt.Sprintf only concatenates strings, replace it with a + b/
+}
@@ -0,0 +1,33 @@
+package fixtures
+
+import (
+	"errors"
+	"fmt"
+	"net"
+)
+
+type name string
+
+type color string
+
+func (c color) String() string { return "#" + string(c) }
+
+func formats(a, b string, n name, c color, ip net.IP, i int, err error) {
+	_ = fmt.Sprintf("%s", a)             // MATCH /fmt.Sprintf only concatenates strings, replace it with a/
+	_ = fmt.Sprintf("%s%s", a, b)        // MATCH /fmt.Sprintf only concatenates strings, replace it with a + b/
+	_ = fmt.Sprintf("%s: %s!", a, b)     // MATCH /fmt.Sprintf only concatenates strings, replace it with a + ": " + b + "!"/
+	_ = fmt.Sprintf("name=%s", n)        // MATCH /fmt.Sprintf only concatenates strings, replace it with "name=" + string(n)/
+	_ = fmt.Sprintf("%s/%s", ip, "mask") // MATCH /fmt.Sprintf only concatenates strings, replace it with ip.String() + "/" + "mask"/
+
+	_ = fmt.Sprintf("%s", c) // MATCH /fmt.Sprintf only concatenates strings, replace it with c.String()/
+	_ = fmt.Sprintf("%s", err)
+	_ = fmt.Sprintf("%s", errors.New("boom"))
+	_ = fmt.Sprintf("%s=%d", a, i)
+	_ = fmt.Sprintf("%v", a)
+	_ = fmt.Sprintf("%q", a)
+	_ = fmt.Sprintf("%10s", a)
+	_ = fmt.Sprintf("%s%%", a)
+	_ = fmt.Sprintf("%s", i)
+	_ = fmt.Sprintf("plain")
+	_ = fmt.Sprint(a, b)
+}
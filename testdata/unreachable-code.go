@@ -58,3 +58,60 @@ func TestA(t *testing.T) {
 		}
 	}
 }
+
+func panics() {
+	panic("not implemented") // MATCH /unreachable code after this statement/
+	fmt.Println("unreachable")
+}
+
+func endless(ch chan int) {
+	for { // MATCH /unreachable code after this statement/
+		fmt.Println(<-ch)
+	}
+	fmt.Println("unreachable")
+}
+
+func endlessWithInnerBreaks(ch chan int) {
+	for { // MATCH /unreachable code after this statement/
+		select {
+		case v := <-ch:
+			if v == 0 {
+				break
+			}
+		}
+		for range ch {
+			break
+		}
+	}
+	fmt.Println("unreachable")
+}
+
+func breaks(ch chan int) {
+	for {
+		if <-ch == 0 {
+			break
+		}
+	}
+	fmt.Println("reachable")
+
+outer:
+	for {
+		select {
+		case <-ch:
+			break outer
+		}
+	}
+	fmt.Println("reachable")
+
+	for i := 0; ; i++ {
+		switch i {
+		case 10:
+			return
+		}
+	}
+}
+
+func blocks() {
+	select {} // MATCH /unreachable code after this statement/
+	fmt.Println("unreachable")
+}
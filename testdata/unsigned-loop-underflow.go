@@ -0,0 +1,28 @@
+package fixtures
+
+type counter uint16
+
+func loops(n int, s []string) {
+	for i := uint(n); i >= 0; i-- { // MATCH /i is unsigned thus i >= 0 is always true and this loop never ends, i wraps around instead of becoming negative/
+	}
+
+	for i := uint8(n); 0 <= i; i -= 2 { // MATCH /i is unsigned thus 0 <= i is always true and this loop never ends, i wraps around instead of becoming negative/
+	}
+
+	var c counter
+	for c = 10; c >= 0; c-- { // MATCH /c is unsigned thus c >= 0 is always true and this loop never ends, c wraps around instead of becoming negative/
+	}
+
+	for i := n; i >= 0; i-- {
+	}
+
+	for i := uint(n); i > 0; i-- {
+	}
+
+	for i := uint(0); i >= 0; i++ {
+		break
+	}
+
+	for i := len(s) - 1; i >= 0; i-- {
+	}
+}
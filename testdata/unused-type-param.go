@@ -0,0 +1,38 @@
+package pkg
+
+func Map[T, U any](s []T, f func(T) U) []U {
+	result := make([]U, 0, len(s))
+	for _, v := range s {
+		result = append(result, f(v))
+	}
+	return result
+}
+
+func Count[T any, K comparable](s []T) int { // MATCH /type parameter K of function Count is never used, remove it/
+	return len(s)
+}
+
+func Zero[T any]() T {
+	var zero T
+	return zero
+}
+
+func Keys[M ~map[K]V, K comparable, V any](m M) []K {
+	keys := make([]K, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+type Box[T any] struct {
+	value T
+}
+
+func (b Box[T]) Size() int { return 1 }
+
+type Tagged[T any, Tag any] struct { // MATCH /type parameter Tag of type Tagged is never used, remove it/
+	value T
+}
+
+func Ignored[_ any]() {}
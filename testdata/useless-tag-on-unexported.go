@@ -0,0 +1,18 @@
+package fixtures
+
+type embedded struct {
+	Value int `json:"value"`
+}
+
+type User struct {
+	embedded `json:"embedded"`
+	Name     string `json:"name"`
+	email    string `json:"email"`                   // MATCH /field email is unexported, thus encoders ignore its json tag; export the field or remove the tag/
+	age      int    `json:"age" yaml:"age" db:"age"` // MATCH /field age is unexported, thus encoders ignore its json/yaml tag; export the field or remove the tag/
+	password string `json:"-"`
+	id       int    `db:"id"`
+	token    string
+	nested   struct {
+		inner string `xml:"inner"` // MATCH /field inner is unexported, thus encoders ignore its xml tag; export the field or remove the tag/
+	}
+}
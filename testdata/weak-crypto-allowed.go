@@ -0,0 +1,13 @@
+package pkg
+
+import (
+	"crypto/des"
+	"crypto/md5"
+	"crypto/sha1"
+)
+
+func checksums(data []byte) {
+	md5.Sum(data)
+	sha1.Sum(data)
+	_, _ = des.NewCipher(data) // MATCH /des.NewCipher relies on crypto/des that is cryptographically weak, use crypto/aes instead/
+}
@@ -0,0 +1,20 @@
+package pkg
+
+import (
+	"crypto/des"
+	"crypto/md5"
+	"crypto/rc4"
+	"crypto/sha1"
+	"crypto/sha256"
+	mrand "math/rand"
+)
+
+func hashes(data []byte) {
+	md5.Sum(data)              // MATCH /md5.Sum relies on crypto/md5 that is cryptographically weak, use crypto/sha256 instead/
+	h := sha1.New()            // MATCH /sha1.New relies on crypto/sha1 that is cryptographically weak, use crypto/sha256 instead/
+	_, _ = des.NewCipher(data) // MATCH /des.NewCipher relies on crypto/des that is cryptographically weak, use crypto/aes instead/
+	_, _ = rc4.NewCipher(data) // MATCH /rc4.NewCipher relies on crypto/rc4 that is cryptographically weak, use crypto/aes instead/
+	_ = mrand.Intn(10)         // MATCH /mrand.Intn relies on math/rand that is cryptographically weak, use crypto/rand instead/
+	_ = sha256.Sum256(data)
+	_ = h
+}
@@ -0,0 +1,54 @@
+package pkg
+
+import (
+	"errors"
+	"fmt"
+)
+
+type parseError struct {
+	line int
+	err  error
+}
+
+func (e *parseError) Error() string { return fmt.Sprintf("line %d: %v", e.line, e.err) }
+func (e *parseError) Unwrap() error { return e.err }
+
+type timeoutError string
+
+func (e timeoutError) Error() string { return string(e) }
+
+type ValidationError struct{ Field string }
+
+func (e ValidationError) Error() string { return "invalid " + e.Field }
+
+var ErrEmpty = errors.New("empty input")
+
+func Parse(input string) (int, error) {
+	if input == "" {
+		return 0, ErrEmpty
+	}
+	if input == "?" {
+		return 0, ValidationError{Field: "input"}
+	}
+	if input == "!" {
+		return 0, timeoutError("timeout") // MATCH /exported function Parse returns an error of unexported type timeoutError, callers in other packages can not target it with errors.As; export the type or return a sentinel error/
+	}
+
+	err := &parseError{line: 1, err: ErrEmpty}
+	return 0, err // MATCH /exported function Parse returns an error of unexported type *parseError, callers in other packages can not target it with errors.As; export the type or return a sentinel error/
+}
+
+type Parser struct{}
+
+func (Parser) Parse() error {
+	return &parseError{} // MATCH /exported method Parse returns an error of unexported type *parseError, callers in other packages can not target it with errors.As; export the type or return a sentinel error/
+}
+
+func parse() error {
+	return &parseError{}
+}
+
+func Wrap(err error) error {
+	check := func() error { return &parseError{err: err} }
+	return fmt.Errorf("wrapped: %w", check())
+}
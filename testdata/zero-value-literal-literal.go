@@ -0,0 +1,17 @@
+package pkg
+
+type point struct{ x, y int }
+
+var defaults point
+
+func zeroValues() {
+	var p point    // MATCH /use p := point{} instead of var p point/
+	var arr [3]int // MATCH /use arr := [3]int{} instead of var arr [3]int/
+	var s []int
+	var i int
+	var m map[string]int
+	var q = point{}
+	r := point{}
+
+	_, _, _, _, _, _, _ = p, arr, s, i, m, q, r
+}
@@ -0,0 +1,26 @@
+package pkg
+
+type point struct{ x, y int }
+
+type grid [4]int
+
+var origin = point{} // MATCH /use var origin point instead of var origin = point{}/
+
+var defaults point
+
+func zeroValues() {
+	p := point{}                 // MATCH /use var p point instead of p := point{}/
+	g := grid{}                  // MATCH /use var g grid instead of g := grid{}/
+	var q = point{}              // MATCH /use var q point instead of var q = point{}/
+	a, b := point{}, point{x: 1} // MATCH /use var a point instead of a := point{}/
+
+	s := []int{}
+	m := map[string]int{}
+	r := &point{}
+	full := point{1, 2}
+	var typed point = point{}
+	var zero point
+
+	_, _, _, _, _, _, _, _, _, _ = p, g, q, a, b, s, m, r, full, typed
+	_ = zero
+}